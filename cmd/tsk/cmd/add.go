@@ -0,0 +1,399 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	addStart       string
+	addEnd         string
+	addDuration    time.Duration
+	addAllDay      bool
+	addCalendar    string
+	addAttendees   []string
+	addLocation    string
+	addDescription string
+	addMeet        bool
+	addTeams       bool
+	addYes         bool
+)
+
+var addCmd = &cobra.Command{
+	Use:   "add <title | description>",
+	Short: "Create a calendar event",
+	Long: `Create an event in one of your calendars and invite attendees.
+
+Without --start, the event is described in plain words and tsk picks out
+the date, time, length, location and calendar; the rest is the title:
+
+  tsk add "Lunch with Priya tomorrow 12:30-13:30 at Cafe Mondo"
+  tsk add "Standup friday 9:30 for 15m @work"
+  tsk add "Planning next week 2-3pm"
+  tsk add "Conference on 2026-03-04 all day"
+
+  Dates:      today, tomorrow, friday, next tuesday, next week, 2026-03-04, 03/04
+  Times:      14:00, 2pm, 9:30am, at 3pm
+  Ranges:     12:30-13:30, 2-3pm, 2pm to 3:30pm, from 9am until 11am
+  Lengths:    for 45m, for 1h30m, for 2 hours, for an hour, for 3 days
+  Location:   at <place>
+  Calendar:   @<calendar name>
+
+A preview is shown before the event is created; --yes skips it.
+
+With --start, the argument is the title as is. Start and end accept the
+same dates as --from/--to (YYYY-MM-DD, 'today', 'tomorrow', weekday
+names, ...), optionally followed by a time (14:00, 2pm, 9:30am). A time on
+its own means today; a date on its own creates an all-day event.
+
+Examples:
+  # One-hour meeting tomorrow at 2pm
+  tsk add "Design review" --start "tomorrow 14:00"
+
+  # Same, in words
+  tsk add "Design review tomorrow 2pm"
+
+  # With an end time, attendees and a Google Meet link
+  tsk add "1:1" --start "friday 10:00" --end 10:30 -a alex@example.com --meet
+
+  # Teams meeting in a specific calendar
+  tsk add "Client sync" --start "2026-03-04 15:00" --duration 45m --calendar Work --teams
+
+  # All-day event spanning three days
+  tsk add "Offsite" --start 2026-03-10 --end 2026-03-12
+
+Supported providers: Google Calendar and Outlook.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runAdd,
+}
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+	addCmd.Flags().StringVar(&addStart, "start", "", "Start date and time (e.g. 'tomorrow 14:00', '2026-03-04 9am')")
+	addCmd.Flags().StringVar(&addEnd, "end", "", "End time or date (default: start + duration)")
+	addCmd.Flags().DurationVar(&addDuration, "duration", time.Hour, "Length of the event when --end is not given")
+	addCmd.Flags().BoolVar(&addAllDay, "all-day", false, "Create an all-day event")
+	addCmd.Flags().StringVar(&addCalendar, "calendar", "", "Calendar name or ID (default: primary calendar)")
+	addCmd.Flags().StringSliceVarP(&addAttendees, "attendee", "a", nil, "Email address to invite (repeatable or comma-separated)")
+	addCmd.Flags().StringVarP(&addLocation, "location", "l", "", "Location")
+	addCmd.Flags().StringVar(&addDescription, "description", "", "Description")
+	addCmd.Flags().BoolVar(&addMeet, "meet", false, "Add a Google Meet link (Google Calendar)")
+	addCmd.Flags().BoolVar(&addTeams, "teams", false, "Add a Microsoft Teams meeting (Outlook)")
+	addCmd.Flags().BoolVarP(&addYes, "yes", "y", false, "Create the event without asking for confirmation")
+}
+
+func runAdd(cmd *cobra.Command, args []string) error {
+	text := strings.TrimSpace(strings.Join(args, " "))
+	if text == "" {
+		return fmt.Errorf("the event needs a title")
+	}
+	if addMeet && addTeams {
+		return fmt.Errorf("choose one of --meet or --teams")
+	}
+
+	draft := core.EventDraft{
+		Title:       text,
+		Description: addDescription,
+		Location:    addLocation,
+		Attendees:   cleanAttendees(addAttendees),
+	}
+	switch {
+	case addMeet:
+		draft.Conference = core.ConferenceGoogleMeet
+	case addTeams:
+		draft.Conference = core.ConferenceTeams
+	}
+
+	calendarName := addCalendar
+	if addStart != "" {
+		if err := setDraftTime(&draft, addStart, addEnd, addDuration, addAllDay); err != nil {
+			return err
+		}
+	} else {
+		// Flags win over what the sentence says
+		qa, err := parseQuickAdd(text, time.Now())
+		if err != nil {
+			return err
+		}
+		draft.Title = qa.Title
+		if draft.Location == "" {
+			draft.Location = qa.Location
+		}
+		if calendarName == "" {
+			calendarName = qa.Calendar
+		}
+		qa.AllDay = qa.AllDay || addAllDay
+		qa.apply(&draft, addDuration, time.Now())
+		if addEnd != "" {
+			end, _, err := parseDateTime(addEnd, draft.Start)
+			if err != nil {
+				return fmt.Errorf("invalid end: %w", err)
+			}
+			if !end.After(draft.Start) {
+				return fmt.Errorf("end time must be after start time")
+			}
+			draft.End = end
+		}
+	}
+
+	calendarID, err := targetCalendar(calendarName)
+	if err != nil {
+		return err
+	}
+	creator, ok := adapter.(core.EventCreator)
+	if !ok || !canCreate(calendarID) {
+		return formatCreateError(core.ErrNotImplemented)
+	}
+
+	if !addYes {
+		printDraftPreview(draft, adapter.Calendars()[calendarID])
+		if !confirm("Create this event?") {
+			return fmt.Errorf("cancelled, nothing was created")
+		}
+		fmt.Println()
+	}
+
+	event, err := creator.CreateEvent(cmd.Context(), calendarID, draft)
+	if err != nil {
+		return formatCreateError(err)
+	}
+
+	printCreatedEvent(event, draft.Attendees)
+	return nil
+}
+
+// printCreatedEvent shows an event that was just created.
+func printCreatedEvent(event core.Event, invited []string) {
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println("  ✅ EVENT CREATED")
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println()
+	DisplayEvent(event, DetailedDisplayOptions())
+	if len(invited) > 0 {
+		fmt.Printf("  ✉️  Invited:     %s\n", strings.Join(invited, ", "))
+	}
+}
+
+// printDraftPreview shows the event about to be created.
+func printDraftPreview(draft core.EventDraft, calendarName string) {
+	fmt.Printf("  %s\n", draft.Title)
+	if calendarName != "" {
+		fmt.Printf("  📅 Calendar:    %s\n", calendarName)
+	}
+	fmt.Printf("  🕐 When:        %s\n", formatEventTime(draft.Start, draft.End, draft.IsAllDay))
+	fmt.Printf("  ⏱️  Duration:    %s\n", formatDurationCompact(draft.End.Sub(draft.Start)))
+	if draft.Location != "" {
+		fmt.Printf("  📍 Location:    %s\n", draft.Location)
+	}
+	if draft.Conference != core.ConferenceNone {
+		fmt.Printf("  📹 Video call:  %s\n", draft.Conference)
+	}
+	if draft.Description != "" {
+		fmt.Printf("  📝 Description: %s\n", truncate(draft.Description, 60))
+	}
+	if len(draft.Attendees) > 0 {
+		fmt.Printf("  ✉️  Invite:      %s\n", strings.Join(draft.Attendees, ", "))
+	}
+	fmt.Println()
+}
+
+// confirm asks a yes/no question on the terminal; Enter means yes.
+func confirm(question string) bool {
+	fmt.Printf("%s [Y/n] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println()
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "" || answer == "y" || answer == "yes"
+}
+
+// targetCalendar resolves the calendar to write to: the named one, or the
+// primary calendar.
+func targetCalendar(name string) (string, error) {
+	if name != "" {
+		ids := resolveCalendarNames([]string{name}, adapter.Calendars())
+		if len(ids) == 0 {
+			return "", fmt.Errorf("calendar not found: %s\n\nRun 'tsk calendars' to list your calendars", name)
+		}
+		return ids[0], nil
+	}
+
+	calendarID := detectPrimaryCalendar(viper.GetString("primary_calendar"))
+	if calendarID == "" {
+		return "", fmt.Errorf("no calendar found to write to")
+	}
+	return calendarID, nil
+}
+
+// canCreate reports whether events can be created in a calendar. The cache
+// and multi-account adapters implement core.EventCreator whatever the
+// provider behind them, so they are asked about that provider.
+func canCreate(calendarID string) bool {
+	if p, ok := adapter.(interface{ CanCreate(calendarID string) bool }); ok {
+		return p.CanCreate(calendarID)
+	}
+	_, ok := adapter.(core.EventCreator)
+	return ok
+}
+
+// setDraftTime fills in the draft's start and end. A start without a time
+// (or --all-day) makes an all-day event; its end date is inclusive.
+func setDraftTime(draft *core.EventDraft, startStr, endStr string, duration time.Duration, allDay bool) error {
+	start, hasTime, err := parseDateTime(startStr, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid start: %w", err)
+	}
+	draft.IsAllDay = allDay || !hasTime
+
+	if draft.IsAllDay {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+		end := start
+		if endStr != "" {
+			end, _, err = parseDateTime(endStr, start)
+			if err != nil {
+				return fmt.Errorf("invalid end: %w", err)
+			}
+			end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+		}
+		if end.Before(start) {
+			return fmt.Errorf("end date must not be before the start date")
+		}
+		draft.Start = start
+		draft.End = end.AddDate(0, 0, 1)
+		return nil
+	}
+
+	end := start.Add(duration)
+	if endStr != "" {
+		end, _, err = parseDateTime(endStr, start)
+		if err != nil {
+			return fmt.Errorf("invalid end: %w", err)
+		}
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	draft.Start = start
+	draft.End = end
+	return nil
+}
+
+// parseDateTime parses a date with an optional time of day: anything
+// parseDate accepts, followed by a clock time ("tomorrow 14:00",
+// "2026-03-04 9am"), or a clock time alone, which falls on the date of
+// ref. RFC 3339 and "2006-01-02T15:04" are accepted too. hasTime reports
+// whether a time of day was given.
+func parseDateTime(s string, ref time.Time) (t time.Time, hasTime bool, err error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04", s, time.Local); err == nil {
+		return t, true, nil
+	}
+
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return time.Time{}, false, fmt.Errorf("empty date")
+	}
+
+	// "9:30 pm" is one clock time
+	if n := len(fields); n >= 2 && (fields[n-1] == "am" || fields[n-1] == "pm") {
+		fields = append(fields[:n-2], fields[n-2]+fields[n-1])
+	}
+
+	hour, minute, isClock := parseClock(fields[len(fields)-1])
+	if !isClock {
+		date, err := parseDate(strings.Join(fields, " "), ref)
+		return date, false, err
+	}
+
+	date := ref
+	if len(fields) > 1 {
+		date, err = parseDate(strings.Join(fields[:len(fields)-1], " "), ref)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, time.Local), true, nil
+}
+
+// parseClock parses a time of day: 14:00, 9am, 9:30pm, 12pm.
+func parseClock(s string) (hour, minute int, ok bool) {
+	suffix := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		suffix = s[len(s)-2:]
+		s = s[:len(s)-2]
+	}
+
+	hourStr, minuteStr, hasMinutes := strings.Cut(s, ":")
+	if !hasMinutes && suffix == "" {
+		return 0, 0, false // a bare number is not a time
+	}
+
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	if hasMinutes {
+		if len(minuteStr) != 2 {
+			return 0, 0, false
+		}
+		if minute, err = strconv.Atoi(minuteStr); err != nil || minute > 59 {
+			return 0, 0, false
+		}
+	}
+
+	switch suffix {
+	case "":
+		if hour > 23 {
+			return 0, 0, false
+		}
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		hour %= 12
+		if suffix == "pm" {
+			hour += 12
+		}
+	}
+	return hour, minute, true
+}
+
+// cleanAttendees trims the addresses and drops empty ones.
+func cleanAttendees(emails []string) []string {
+	var result []string
+	for _, email := range emails {
+		if email = strings.TrimSpace(email); email != "" {
+			result = append(result, email)
+		}
+	}
+	return result
+}
+
+// formatCreateError converts core errors to user-friendly messages.
+func formatCreateError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return fmt.Errorf("insufficient permissions to create events\n\nPlease re-authenticate with updated permissions:\n  tsk auth")
+	case errors.Is(err, core.ErrNotImplemented):
+		return fmt.Errorf("creating events is not supported for this provider\n\nCurrently supported:\n  ✅ Google Calendar\n  ✅ Outlook")
+	case errors.Is(err, core.ErrOffline):
+		return fmt.Errorf("cannot create events while offline\n\nRun the command again without --offline once you are connected")
+	default:
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+}
@@ -8,13 +8,14 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/adapter/outlook"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
-	"golang.org/x/oauth2/microsoft"
 	"google.golang.org/api/calendar/v3"
 )
 
@@ -38,7 +39,11 @@ For Outlook / Office 365:
   2. Opens your browser to sign in with Microsoft
   3. Saves the token for future use
 
-The provider is determined by your profile configuration (provider: google|outlook).`,
+CalDAV accounts don't need this; they authenticate with the credentials
+in the profile configuration. Neither do local .ics calendars or
+subscribed feeds.
+
+The provider is determined by your profile configuration (provider: google|outlook|caldav|ics|ics_url).`,
 	RunE:              runAuth,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil }, // Skip adapter init
 }
@@ -48,6 +53,10 @@ func init() {
 }
 
 func runAuth(cmd *cobra.Command, args []string) error {
+	if accounts := viper.GetStringSlice("profiles." + activeProfile + ".accounts"); activeProfile != "" && len(accounts) > 0 {
+		return fmt.Errorf("profile '%s' combines several accounts; authenticate each one instead:\n  tsk auth -p %s", activeProfile, strings.Join(accounts, "\n  tsk auth -p "))
+	}
+
 	provider := viper.GetString("provider")
 
 	switch provider {
@@ -55,8 +64,14 @@ func runAuth(cmd *cobra.Command, args []string) error {
 		return runGoogleAuth(cmd, args)
 	case "outlook":
 		return runOutlookAuth(cmd, args)
+	case "caldav":
+		return fmt.Errorf("CalDAV uses the username and password (or bearer_token) from your profile config; no 'tsk auth' needed")
+	case "ics":
+		return fmt.Errorf("the ics provider reads local files; no 'tsk auth' needed")
+	case "ics_url":
+		return fmt.Errorf("the ics_url provider reads public feeds; no 'tsk auth' needed")
 	default:
-		return fmt.Errorf("unknown provider: %s (supported: google, outlook)", provider)
+		return fmt.Errorf("unknown provider: %s (supported: google, outlook, caldav, ics, ics_url)", provider)
 	}
 }
 
@@ -98,23 +113,11 @@ func runOutlookAuth(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("client_id not configured\n\nAdd it to your profile config:\n  client_id: \"your-azure-app-client-id\"\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/outlook_setup.md")
 	}
 
-	tenantID := viper.GetString("tenant_id")
-	if tenantID == "" {
-		tenantID = "common"
-	}
-
 	tokenFile := expandPath(viper.GetString("token_file"))
 
-	config := &oauth2.Config{
-		ClientID:    clientID,
-		Endpoint:    microsoft.AzureADEndpoint(tenantID),
-		RedirectURL: redirectURL,
-		Scopes: []string{
-			"https://graph.microsoft.com/Calendars.Read",
-			"https://graph.microsoft.com/User.Read",
-			"offline_access",
-		},
-	}
+	// Use the adapter's config so the token has the scopes it needs
+	config := outlook.NewOutlookAdapter("", "", clientID, viper.GetString("tenant_id"), tokenFile).OAuthConfig()
+	config.RedirectURL = redirectURL
 
 	tok, err := getTokenViaLocalServer(config, "Microsoft", oauth2.SetAuthURLParam("prompt", "consent"))
 	if err != nil {
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -14,13 +16,32 @@ var calendarsCmd = &cobra.Command{
 	RunE:    runCalendars,
 }
 
+var calendarsOutput string
+
 func init() {
 	rootCmd.AddCommand(calendarsCmd)
+	calendarsCmd.Flags().StringVarP(&calendarsOutput, "output", "o", "text", "Output format: text, json, jsonl, csv, tsv or yaml")
 }
 
 func runCalendars(cmd *cobra.Command, args []string) error {
+	if err := checkOutputFormat(calendarsOutput); err != nil {
+		return err
+	}
+
 	calendars := adapter.Calendars()
 
+	// The calendar list is as old as the last sync of any range
+	notice := offlineNotice(time.Time{}, time.Time{})
+	if calendarsOutput != "text" {
+		if notice != "" {
+			fmt.Fprintln(os.Stderr, notice)
+		}
+		return writeCalendars(os.Stdout, calendarsOutput, calendars)
+	}
+
+	if notice != "" {
+		fmt.Println(notice)
+	}
 	fmt.Println("📅 Available calendars:")
 	fmt.Println("─────────────────────────────────────────────────")
 
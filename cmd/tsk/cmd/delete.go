@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	deleteMessage      string
+	deleteAllInstances bool
+	deleteScope        string
+	deleteYes          bool
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete <calendar:event-id>",
+	Aliases: []string{"cancel"},
+	Short:   "Delete or cancel an event you organize",
+	Long: `Delete an event you organize. If it has attendees, they are sent a
+cancellation, with an optional message.
+
+The event is shown and you are asked to confirm before anything is
+deleted; --yes skips the question.
+
+Event reference format: calendarID:eventID
+  Example: primary:abc123xyz
+
+To find event IDs, enable display.id in your config and run 'tsk' or 'tsk next'.
+
+Examples:
+  # Delete an event
+  tsk delete primary:abc123
+
+  # Cancel a meeting and tell the attendees why
+  tsk cancel primary:abc123 -m "Sorry, moving this to next week"
+
+  # Delete every instance of a recurring event, without asking
+  tsk delete primary:abc123 --all-instances --yes
+
+  # End a weekly meeting: delete this instance and every later one
+  tsk delete primary:abc123 --scope following
+
+Recurring events:
+  By default, only the given instance is deleted. Use --scope following to
+  end the series before this instance, or --scope all (--all-instances) to
+  delete the whole series. Ending a series updates attendees' calendars
+  rather than cancelling, so it can't be combined with --message.
+
+Supported providers: Google Calendar and Outlook.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().StringVarP(&deleteMessage, "message", "m", "", "Message to attendees with the cancellation")
+	deleteCmd.Flags().BoolVar(&deleteAllInstances, "all-instances", false, "Delete all instances of a recurring event")
+	deleteCmd.Flags().StringVar(&deleteScope, "scope", "", "Instances of a recurring event to delete: this, following or all")
+	deleteCmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	calendarID, eventID, err := parseEventReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	scope, err := parseScope(deleteScope, deleteAllInstances)
+	if err != nil {
+		return err
+	}
+	if scope == core.RecurringScopeThisAndFollowing && deleteMessage != "" {
+		// Ending a series sends attendees an update, which has no room for
+		// a message
+		return fmt.Errorf("--message cannot be combined with --scope following: ending a series sends attendees an update, not a cancellation")
+	}
+
+	opts := core.DeleteOptions{
+		Message: deleteMessage,
+		Scope:   scope,
+	}
+
+	deleter, ok := adapter.(core.EventDeleter)
+	if !ok {
+		return formatDeleteError(core.ErrNotImplemented)
+	}
+
+	if !deleteYes {
+		// The preview is a courtesy; an event outside the lookup window
+		// can still be deleted
+		if event, err := findEvent(cmd.Context(), calendarID, eventID); err == nil {
+			DisplayEvent(event, DetailedDisplayOptions())
+			fmt.Println()
+		}
+		question := "Delete this event?"
+		switch opts.Scope {
+		case core.RecurringScopeAllInstances:
+			question = "Delete all events in the series?"
+		case core.RecurringScopeThisAndFollowing:
+			question = "Delete this and all following events?"
+		}
+		if !confirm(question) {
+			return fmt.Errorf("cancelled, nothing was deleted")
+		}
+		fmt.Println()
+	}
+
+	if err := deleter.DeleteEvent(cmd.Context(), calendarID, eventID, opts); err != nil {
+		return formatDeleteError(err)
+	}
+
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println("  ✅ EVENT DELETED")
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println()
+	if opts.Scope != core.RecurringScopeThisInstance {
+		fmt.Printf("  Scope:    %s\n", opts.Scope.String())
+	}
+	if opts.Message != "" {
+		fmt.Printf("  Message:  %s\n", opts.Message)
+	}
+	fmt.Println("  Attendees, if any, have been sent a cancellation.")
+
+	return nil
+}
+
+// formatDeleteError converts core errors to user-friendly messages.
+func formatDeleteError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return fmt.Errorf("insufficient permissions to delete events\n\nPlease re-authenticate with updated permissions:\n  tsk auth")
+	case errors.Is(err, core.ErrNotImplemented):
+		return fmt.Errorf("deleting events is not supported for this provider\n\nCurrently supported:\n  ✅ Google Calendar\n  ✅ Outlook")
+	case errors.Is(err, core.ErrNotOrganizer):
+		return fmt.Errorf("only the organizer can delete this event\n\nTo drop it from your calendar, decline it instead:\n  tsk respond <calendar:event-id> --decline")
+	case errors.Is(err, core.ErrOffline):
+		return fmt.Errorf("cannot delete events while offline\n\nRun the command again without --offline once you are connected")
+	default:
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+}
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	editTitle           string
+	editDescription     string
+	editLocation        string
+	editStart           string
+	editEnd             string
+	editDuration        time.Duration
+	editAllDay          bool
+	editAttendees       []string
+	editRemoveAttendees []string
+	editAllInstances    bool
+	editScope           string
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit <calendar:event-id>",
+	Short: "Change or reschedule an event you organize",
+	Long: `Change the title, time, location, description or guest list of an event
+you organize. Attendees are notified by the provider.
+
+Only the fields given as flags change. An empty value clears a field:
+  tsk edit primary:abc123 --location ""
+
+Rescheduling:
+  --start alone moves the event and keeps its length; a time on its own
+  (14:00, 2pm) keeps the event's date. --end alone changes when it ends,
+  --duration how long it lasts. A date without a time makes the event
+  all-day; for all-day events the end date is inclusive.
+
+Event reference format: calendarID:eventID
+  Example: primary:abc123xyz
+
+To find event IDs, enable display.id in your config and run 'tsk' or 'tsk next'.
+
+Examples:
+  # Rename an event
+  tsk edit primary:abc123 --title "Design review (v2)"
+
+  # Push it back an hour
+  tsk edit primary:abc123 --start 15:00
+
+  # Move it to Friday morning and make it 30 minutes
+  tsk edit primary:abc123 --start "friday 9:30" --duration 30m
+
+  # Invite one person and drop another
+  tsk edit primary:abc123 -a sam@example.com --remove-attendee alex@example.com
+
+  # Change every instance of a recurring event
+  tsk edit primary:abc123 --location "Room 4" --all-instances
+
+  # Move this and every later instance to 10:00
+  tsk edit primary:abc123 --start 10:00 --scope following
+
+Recurring events:
+  By default, only the given instance changes. Use --scope all
+  (--all-instances) to change the whole series; a new time then moves every
+  instance by the same amount. --scope following splits the series: it ends
+  before this instance and a new series with the changes carries on.
+
+Supported providers: Google Calendar and Outlook.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEdit,
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+	editCmd.Flags().StringVar(&editTitle, "title", "", "New title")
+	editCmd.Flags().StringVar(&editDescription, "description", "", "New description")
+	editCmd.Flags().StringVarP(&editLocation, "location", "l", "", "New location")
+	editCmd.Flags().StringVar(&editStart, "start", "", "New start date and time (e.g. 'tomorrow 14:00', '15:00')")
+	editCmd.Flags().StringVar(&editEnd, "end", "", "New end time or date")
+	editCmd.Flags().DurationVar(&editDuration, "duration", 0, "New length of the event")
+	editCmd.Flags().BoolVar(&editAllDay, "all-day", false, "Make the event all-day")
+	editCmd.Flags().StringSliceVarP(&editAttendees, "attendee", "a", nil, "Email address to invite (repeatable or comma-separated)")
+	editCmd.Flags().StringSliceVar(&editRemoveAttendees, "remove-attendee", nil, "Email address to remove from the event (repeatable or comma-separated)")
+	editCmd.Flags().BoolVar(&editAllInstances, "all-instances", false, "Change all instances of a recurring event")
+	editCmd.Flags().StringVar(&editScope, "scope", "", "Instances of a recurring event to change: this, following or all")
+}
+
+func runEdit(cmd *cobra.Command, args []string) error {
+	calendarID, eventID, err := parseEventReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	scope, err := parseScope(editScope, editAllInstances)
+	if err != nil {
+		return err
+	}
+
+	update := core.EventUpdate{
+		AddAttendees:    cleanAttendees(editAttendees),
+		RemoveAttendees: cleanAttendees(editRemoveAttendees),
+		Scope:           scope,
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("title") {
+		title := strings.TrimSpace(editTitle)
+		if title == "" {
+			return fmt.Errorf("the title cannot be empty")
+		}
+		update.Title = &title
+	}
+	if flags.Changed("description") {
+		update.Description = &editDescription
+	}
+	if flags.Changed("location") {
+		update.Location = &editLocation
+	}
+
+	if editStart != "" || editEnd != "" || editDuration != 0 || editAllDay {
+		// Whatever isn't given is taken from the event as it is now
+		event, err := findEvent(cmd.Context(), calendarID, eventID)
+		if err != nil {
+			return err
+		}
+		if err := setUpdateTime(&update, event, editStart, editEnd, editDuration, editAllDay); err != nil {
+			return err
+		}
+	}
+
+	if update.IsEmpty() {
+		return fmt.Errorf("nothing to change\n\nGive at least one of --title, --start, --end, --duration, --all-day,\n--location, --description, --attendee or --remove-attendee")
+	}
+
+	updater, ok := adapter.(core.EventUpdater)
+	if !ok {
+		return formatUpdateError(core.ErrNotImplemented)
+	}
+
+	event, err := updater.UpdateEvent(cmd.Context(), calendarID, eventID, update)
+	if err != nil {
+		return formatUpdateError(err)
+	}
+
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println("  ✅ EVENT UPDATED")
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Println()
+	if update.Scope != core.RecurringScopeThisInstance && event.ID != eventID {
+		fmt.Printf("  Scope:    %s\n\n", update.Scope.String())
+	}
+	DisplayEvent(event, DetailedDisplayOptions())
+	if len(update.AddAttendees) > 0 {
+		fmt.Printf("  ✉️  Invited:     %s\n", strings.Join(update.AddAttendees, ", "))
+	}
+	if len(update.RemoveAttendees) > 0 {
+		fmt.Printf("  🚫 Removed:     %s\n", strings.Join(update.RemoveAttendees, ", "))
+	}
+
+	return nil
+}
+
+// findEvent looks up an event by reference, within a year around today.
+func findEvent(ctx context.Context, calendarID, eventID string) (core.Event, error) {
+	now := time.Now()
+	events, err := adapter.FetchEvents(ctx, core.FetchOptions{
+		Start:        now.AddDate(0, -6, 0),
+		End:          now.AddDate(0, 6, 0),
+		CalendarIDs:  []string{calendarID},
+		IncludeTypes: []core.EventType{core.TypeDefault, core.TypeOutOfOffice, core.TypeFocusTime, core.TypeWorkLocation},
+	})
+	if err != nil {
+		return core.Event{}, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	for _, e := range events {
+		if e.ID != eventID {
+			continue
+		}
+		// A merged event is listed under its first calendar
+		if e.Calendar.ID == calendarID {
+			return e, nil
+		}
+		for _, cr := range e.Calendars {
+			if cr.Calendar.ID == calendarID {
+				return e, nil
+			}
+		}
+	}
+	return core.Event{}, fmt.Errorf("event not found: %s:%s\n\nOnly events within six months of today can be looked up", calendarID, eventID)
+}
+
+// setUpdateTime works out the new start and end of event from the edit
+// flags. Dates and times are read like those of 'tsk add', relative to the
+// event's current start.
+func setUpdateTime(update *core.EventUpdate, event core.Event, startStr, endStr string, duration time.Duration, allDay bool) error {
+	start := event.Start.In(time.Local)
+	hasTime := !event.IsAllDay
+	if event.IsAllDay {
+		// All-day dates are stored as UTC midnight
+		start = time.Date(event.Start.Year(), event.Start.Month(), event.Start.Day(), 0, 0, 0, 0, time.Local)
+	}
+	if startStr != "" {
+		var err error
+		start, hasTime, err = parseDateTime(startStr, start)
+		if err != nil {
+			return fmt.Errorf("invalid start: %w", err)
+		}
+	}
+	update.IsAllDay = allDay || !hasTime
+
+	if update.IsAllDay {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+		if duration != 0 {
+			return fmt.Errorf("--duration does not apply to all-day events, use --end")
+		}
+		// Keep the number of days, or one day for events that were timed
+		days := 1
+		if event.IsAllDay {
+			days = max(1, int(event.End.Sub(event.Start).Round(24*time.Hour)/(24*time.Hour)))
+		}
+		end := start.AddDate(0, 0, days)
+		if endStr != "" {
+			last, _, err := parseDateTime(endStr, start)
+			if err != nil {
+				return fmt.Errorf("invalid end: %w", err)
+			}
+			last = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, last.Location())
+			if last.Before(start) {
+				return fmt.Errorf("end date must not be before the start date")
+			}
+			end = last.AddDate(0, 0, 1)
+		}
+		update.Start = start
+		update.End = end
+		return nil
+	}
+
+	length := time.Hour
+	if !event.IsAllDay {
+		length = event.Duration()
+	}
+	if duration != 0 {
+		length = duration
+	}
+	end := start.Add(length)
+	if endStr != "" {
+		var err error
+		end, _, err = parseDateTime(endStr, start)
+		if err != nil {
+			return fmt.Errorf("invalid end: %w", err)
+		}
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	update.Start = start
+	update.End = end
+	return nil
+}
+
+// formatUpdateError converts core errors to user-friendly messages.
+func formatUpdateError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return fmt.Errorf("insufficient permissions to change events\n\nPlease re-authenticate with updated permissions:\n  tsk auth")
+	case errors.Is(err, core.ErrNotImplemented):
+		return fmt.Errorf("changing events is not supported for this provider\n\nCurrently supported:\n  ✅ Google Calendar\n  ✅ Outlook")
+	case errors.Is(err, core.ErrNotOrganizer):
+		return fmt.Errorf("only the organizer can change this event\n\nYou can propose a new time instead:\n  tsk respond <calendar:event-id> --tentative --propose 14:00/15:00")
+	case errors.Is(err, core.ErrOffline):
+		return fmt.Errorf("cannot change events while offline\n\nRun the command again without --offline once you are connected")
+	default:
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/theakshaypant/tsk/internal/icalendar"
+)
+
+var exportICS string
+
+var exportCmd = &cobra.Command{
+	Use:   "export --ics <file>",
+	Short: "Export events to an iCalendar file",
+	Long: `Write the events 'tsk' would list to an iCalendar (.ics) file, to share a
+filtered view of your calendar or archive a stretch of it.
+
+The date range and all filter flags work as for 'tsk'. Each event keeps its
+UID, so importing the file twice doesn't create duplicates, along with its
+organizer, attendees, location, video call link and attachments.
+
+Recurring events are exported as the instances in the range, each with
+its RECURRENCE-ID; the series itself isn't.
+
+Use '-' as the file name to write to stdout.
+
+Examples:
+  # Next week's work meetings
+  tsk export --ics week.ics --from monday --to friday -c Work
+
+  # Archive a quarter, everything included
+  tsk export --ics q1.ics --from 2026-01-01 --to 2026-03-31 --all-types --accepted=false
+
+  # Pipe it somewhere
+  tsk export --ics - | curl -T - https://dav.example.com/shared.ics`,
+	RunE: runExport,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&exportICS, "ics", "", "File to write, or - for stdout (required)")
+	_ = exportCmd.MarkFlagRequired("ics")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	now := time.Now()
+	events, start, end, err := fetchFilteredEvents(cmd, now)
+	if err != nil {
+		return err
+	}
+
+	if notice := offlineNotice(start, end); notice != "" {
+		fmt.Fprintln(os.Stderr, notice)
+	}
+
+	if exportICS == "-" {
+		_, err := icalendar.Export(os.Stdout, events, now)
+		return err
+	}
+
+	f, err := os.Create(expandPath(exportICS))
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", exportICS, err)
+	}
+	written, err := icalendar.Export(f, events, now)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", exportICS, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", exportICS, err)
+	}
+
+	fmt.Printf("✅ Exported %d events (%s to %s) to %s\n", written, start.Format("Jan 2"), end.Format("Jan 2"), exportICS)
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// templateFuncs are the helpers available in --format templates, on top
+// of text/template's built-ins.
+var templateFuncs = template.FuncMap{
+	// relative describes a time from now: "in 2h 30m", "15m ago", "now"
+	"relative": func(t time.Time) string {
+		return formatRelative(t, time.Now())
+	},
+	// duration formats a length compactly: "45m", "1h 30m", "2d"
+	"duration": formatDurationCompact,
+	// when formats an event's date and time like the text output
+	"when": func(e core.Event) string {
+		return formatEventTime(e.Start, e.End, e.IsAllDay)
+	},
+	// status and eventType are the words used in structured output
+	"status":    statusName,
+	"eventType": eventTypeName,
+	// guests summarizes the attendees' responses: "5 guests: 3 accepted,
+	// ...", or "" without guests
+	"guests": func(e core.Event) string {
+		summary := e.AttendeeSummary()
+		if summary.Total() == 0 {
+			return ""
+		}
+		return formatAttendeeSummary(summary)
+	},
+	// link makes text a terminal hyperlink to url (OSC 8); with no url,
+	// it's just the text
+	"link": func(url, text string) string {
+		if url == "" {
+			return text
+		}
+		return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+	},
+	// truncate shortens s to n characters, marking the cut with "..."
+	"truncate": func(n int, s string) string {
+		runes := []rune(s)
+		if len(runes) <= n {
+			return s
+		}
+		return string(runes[:n]) + "..."
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join":  strings.Join,
+}
+
+// parseEventTemplate parses a --format value: the name of a template from
+// the config (templates.<name>, or the profile's), or a template itself.
+func parseEventTemplate(format string) (*template.Template, error) {
+	text := format
+	if named := namedTemplate(format); named != "" {
+		text = named
+	}
+
+	tmpl, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// namedTemplate looks up a template by name, in the active profile first.
+// It returns "" when there is no such template.
+func namedTemplate(name string) string {
+	if strings.ContainsAny(name, "{} \n.") {
+		return "" // a template, not a name
+	}
+	if activeProfile != "" {
+		if key := "profiles." + activeProfile + ".templates." + name; viper.IsSet(key) {
+			return viper.GetString(key)
+		}
+	}
+	return viper.GetString("templates." + name)
+}
+
+// renderEvents executes tmpl once per event, each on its own line.
+func renderEvents(w io.Writer, tmpl *template.Template, events []core.Event) error {
+	var buf bytes.Buffer
+	for _, e := range events {
+		buf.Reset()
+		if err := tmpl.Execute(&buf, e); err != nil {
+			return fmt.Errorf("failed to render --format template: %w", err)
+		}
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatRelative describes t relative to now.
+func formatRelative(t, now time.Time) string {
+	d := t.Sub(now)
+	switch {
+	case d > -time.Minute && d < time.Minute:
+		return "now"
+	case d > 0:
+		return "in " + formatDurationCompact(d)
+	default:
+		return formatDurationCompact(d) + " ago"
+	}
+}
@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	freeDuration time.Duration
+	freeHours    string
+	freeOutput   string
+)
+
+var freeCmd = &cobra.Command{
+	Use:   "free",
+	Short: "Find free time in your calendar",
+	Long: `List the gaps in your calendar, within working hours, that are at least
+as long as --duration.
+
+Accepted and tentative events count as busy, as do your own events; all-day
+events don't. Days you're out of office (see --smart-ooo) are skipped.
+
+The range is the same as for 'tsk': --from/--to, or --days from now.
+
+Working hours come from the config and can be overridden with --hours:
+
+  working_hours:
+    start: "09:00"
+    end: "17:00"
+    days: [mon, tue, wed, thu, fri]
+
+Examples:
+  # When am I free for 30 minutes this week?
+  tsk free
+
+  # An hour, Monday to Friday
+  tsk free --duration 1h --from monday --to friday
+
+  # Early risers
+  tsk free --hours 07:30-15:30
+
+  # Machine-readable, to paste or pipe elsewhere
+  tsk free --duration 45m -o json`,
+	RunE: runFree,
+}
+
+func init() {
+	rootCmd.AddCommand(freeCmd)
+	freeCmd.Flags().DurationVar(&freeDuration, "duration", 30*time.Minute, "Shortest slot to list")
+	freeCmd.Flags().StringVar(&freeHours, "hours", "", "Working hours, e.g. 09:00-17:00 (default from config)")
+	freeCmd.Flags().StringVarP(&freeOutput, "output", "o", "text", "Output format: text or json")
+}
+
+// FreeSlot is a gap in the calendar.
+type FreeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// workingHours is the part of each working day that can be booked.
+type workingHours struct {
+	start time.Duration // Since midnight
+	end   time.Duration
+	days  map[time.Weekday]bool
+}
+
+func runFree(cmd *cobra.Command, args []string) error {
+	if freeDuration <= 0 {
+		return fmt.Errorf("--duration must be positive")
+	}
+	if freeOutput != "text" && freeOutput != "json" {
+		return fmt.Errorf("invalid --output %q (use text or json)", freeOutput)
+	}
+
+	hours, err := loadWorkingHours(freeHours)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	start, end, err := dateRange(now)
+	if err != nil {
+		return err
+	}
+	if start.Before(now) {
+		start = now
+	}
+
+	busy, err := fetchBusyEvents(cmd, start, end)
+	if err != nil {
+		return err
+	}
+	ooo := getOOOPeriods(cmd.Context(), start, end, detectPrimaryCalendar(viper.GetString("primary_calendar")))
+
+	slots := findFreeSlots(busy, ooo, hours, start, end, freeDuration)
+
+	if freeOutput == "json" {
+		if notice := offlineNotice(start, end); notice != "" {
+			fmt.Fprintln(os.Stderr, notice)
+		}
+		return printFreeSlotsJSON(slots)
+	}
+
+	if notice := offlineNotice(start, end); notice != "" {
+		fmt.Println(notice)
+	}
+	fmt.Printf("🟢 Free for %s or more, %s to %s:\n", formatDurationCompact(freeDuration), start.Format("Jan 2"), end.Format("Jan 2"))
+	fmt.Println("─────────────────────────────────────────────────")
+
+	if len(slots) == 0 {
+		fmt.Println("No free time found.")
+		return nil
+	}
+
+	var day string
+	for _, slot := range slots {
+		if d := slot.Start.Format("Mon Jan 2"); d != day {
+			if day != "" {
+				fmt.Println()
+			}
+			day = d
+			fmt.Println(day)
+		}
+		fmt.Printf("  %s – %s  (%s)\n", slot.Start.Format("15:04"), slot.End.Format("15:04"), formatDurationCompact(slot.End.Sub(slot.Start)))
+	}
+
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Printf("Total: %d slots\n", len(slots))
+
+	return nil
+}
+
+// fetchBusyEvents fetches the events that take up time: accepted,
+// tentative and your own, in the calendars selected with --calendars.
+func fetchBusyEvents(cmd *cobra.Command, start, end time.Time) ([]core.Event, error) {
+	opts := core.FetchOptions{
+		Start:         start,
+		End:           end,
+		IncludeTypes:  []core.EventType{core.TypeDefault, core.TypeFocusTime},
+		ExcludeAllDay: true,
+		IncludeStatuses: []core.EventStatus{
+			core.StatusAccepted,
+			core.StatusTentative,
+			core.StatusNoResponse,
+		},
+	}
+
+	if calendars := viper.GetString("calendars"); calendars != "" {
+		calendarIDs := resolveCalendarNames(strings.Split(calendars, ","), adapter.Calendars())
+		if len(calendarIDs) == 0 {
+			return nil, fmt.Errorf("no matching calendars found for: %s\nUse 'tsk calendars' to see available calendars", calendars)
+		}
+		opts.CalendarIDs = calendarIDs
+	}
+
+	events, err := adapter.FetchEvents(cmd.Context(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+	return events, nil
+}
+
+// findFreeSlots returns the gaps of at least minLength between busy
+// events, within working hours and [start, end). Days you're out of office
+// are skipped, as smart OOO does.
+func findFreeSlots(busy []core.Event, ooo []OOOPeriod, hours workingHours, start, end time.Time, minLength time.Duration) []FreeSlot {
+	sort.Slice(busy, func(i, j int) bool {
+		return busy[i].Start.Before(busy[j].Start)
+	})
+
+	var slots []FreeSlot
+	local := start.Local()
+	for day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !hours.days[day.Weekday()] {
+			continue
+		}
+
+		// time.Date rather than Add, so DST changes keep the clock times
+		from := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.start.Minutes()), 0, 0, time.Local)
+		to := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.end.Minutes()), 0, 0, time.Local)
+		if isEventOnOOODay(core.Event{Start: from, End: to}, ooo) {
+			continue
+		}
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+
+		// Walk the busy events in order, keeping the gaps
+		cursor := from
+		for _, event := range busy {
+			if !event.End.After(cursor) {
+				continue
+			}
+			if !event.Start.Before(to) {
+				break
+			}
+			if event.Start.Sub(cursor) >= minLength {
+				slots = append(slots, FreeSlot{Start: cursor, End: event.Start})
+			}
+			cursor = event.End
+		}
+		if to.Sub(cursor) >= minLength {
+			slots = append(slots, FreeSlot{Start: cursor, End: to})
+		}
+	}
+
+	return slots
+}
+
+// loadWorkingHours reads working hours from the config, with the --hours
+// flag (e.g. "09:00-17:00") taking precedence over start and end.
+func loadWorkingHours(flag string) (workingHours, error) {
+	startStr := viper.GetString("working_hours.start")
+	endStr := viper.GetString("working_hours.end")
+	if flag != "" {
+		parts := strings.SplitN(flag, "-", 2)
+		if len(parts) != 2 {
+			return workingHours{}, fmt.Errorf("invalid --hours %q (use 09:00-17:00)", flag)
+		}
+		startStr, endStr = parts[0], parts[1]
+	}
+
+	var hours workingHours
+	var ok bool
+	if hours.start, ok = sinceMidnight(startStr); !ok {
+		return hours, fmt.Errorf("invalid working hours start %q (use 09:00)", startStr)
+	}
+	if hours.end, ok = sinceMidnight(endStr); !ok {
+		return hours, fmt.Errorf("invalid working hours end %q (use 17:00)", endStr)
+	}
+	if hours.end <= hours.start {
+		return hours, fmt.Errorf("working hours must end after they start")
+	}
+
+	hours.days = make(map[time.Weekday]bool)
+	for _, name := range viper.GetStringSlice("working_hours.days") {
+		wd, ok := parseWeekday(name)
+		if !ok {
+			return hours, fmt.Errorf("invalid working day %q (use mon, tue, ...)", name)
+		}
+		hours.days[wd] = true
+	}
+	return hours, nil
+}
+
+// sinceMidnight parses a time of day (17:00, 5pm) into the time since
+// midnight.
+func sinceMidnight(s string) (time.Duration, bool) {
+	hour, minute, ok := parseClock(strings.ToLower(strings.TrimSpace(s)))
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, true
+}
+
+// parseWeekday parses a day name such as "mon" or "Monday".
+func parseWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if s == name || s == name[:3] {
+			return wd, true
+		}
+	}
+	return 0, false
+}
+
+// printFreeSlotsJSON prints slots as a JSON array.
+func printFreeSlotsJSON(slots []FreeSlot) error {
+	type jsonSlot struct {
+		Start   string `json:"start"`
+		End     string `json:"end"`
+		Minutes int    `json:"minutes"`
+	}
+
+	out := make([]jsonSlot, 0, len(slots))
+	for _, slot := range slots {
+		out = append(out, jsonSlot{
+			Start:   slot.Start.Format(time.RFC3339),
+			End:     slot.End.Format(time.RFC3339),
+			Minutes: int(slot.End.Sub(slot.Start).Minutes()),
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// useLocal sets time.Local for the duration of a test.
+func useLocal(t *testing.T, name string) {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("no time zone data for %s: %v", name, err)
+	}
+	saved := time.Local
+	time.Local = loc
+	t.Cleanup(func() { time.Local = saved })
+}
+
+// local parses "2006-01-02 15:04" in time.Local.
+func local(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func busyEvent(start, end string) core.Event {
+	return core.Event{Start: local(start), End: local(end)}
+}
+
+func TestFindFreeSlots(t *testing.T) {
+	// Berlin switches to summer time on Sunday 2026-03-29
+	useLocal(t, "Europe/Berlin")
+
+	hours := workingHours{
+		start: 9 * time.Hour,
+		end:   17 * time.Hour,
+		days: map[time.Weekday]bool{
+			time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		busy  []core.Event
+		ooo   []OOOPeriod
+		start string
+		end   string
+		// want lists the slots as "Mon 15:04-15:04 MST"
+		want []string
+	}{
+		{
+			name:  "empty day",
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+			want: []string{"Mon 09:00-17:00 CET"},
+		},
+		{
+			name:  "one meeting",
+			busy:  []core.Event{busyEvent("2026-03-02 10:00", "2026-03-02 11:00")},
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+			want: []string{"Mon 09:00-10:00 CET", "Mon 11:00-17:00 CET"},
+		},
+		{
+			name: "overlapping meetings",
+			busy: []core.Event{
+				busyEvent("2026-03-02 10:30", "2026-03-02 12:00"),
+				busyEvent("2026-03-02 10:00", "2026-03-02 11:00"),
+			},
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+			want: []string{"Mon 09:00-10:00 CET", "Mon 12:00-17:00 CET"},
+		},
+		{
+			name: "nested meetings",
+			busy: []core.Event{
+				busyEvent("2026-03-02 10:00", "2026-03-02 13:00"),
+				busyEvent("2026-03-02 11:00", "2026-03-02 12:00"),
+			},
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+			want: []string{"Mon 09:00-10:00 CET", "Mon 13:00-17:00 CET"},
+		},
+		{
+			name: "meetings outside working hours",
+			busy: []core.Event{
+				busyEvent("2026-03-02 08:00", "2026-03-02 09:30"),
+				busyEvent("2026-03-02 16:30", "2026-03-02 18:00"),
+			},
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+			want: []string{"Mon 09:30-16:30 CET"},
+		},
+		{
+			name: "gaps shorter than the minimum",
+			busy: []core.Event{
+				busyEvent("2026-03-02 09:15", "2026-03-02 11:00"),
+				busyEvent("2026-03-02 11:15", "2026-03-02 17:00"),
+			},
+			start: "2026-03-02 00:00", end: "2026-03-03 00:00",
+		},
+		{
+			name:  "clipped to now",
+			busy:  []core.Event{busyEvent("2026-03-02 10:00", "2026-03-02 11:00")},
+			start: "2026-03-02 13:20", end: "2026-03-03 00:00",
+			want: []string{"Mon 13:20-17:00 CET"},
+		},
+		{
+			name:  "clipped to the range end",
+			busy:  []core.Event{busyEvent("2026-03-02 10:00", "2026-03-02 11:00")},
+			start: "2026-03-02 00:00", end: "2026-03-02 15:00",
+			want: []string{"Mon 09:00-10:00 CET", "Mon 11:00-15:00 CET"},
+		},
+		{
+			name:  "weekend skipped",
+			start: "2026-03-07 00:00", end: "2026-03-10 00:00",
+			want: []string{"Mon 09:00-17:00 CET"},
+		},
+		{
+			name:  "out of office day skipped",
+			ooo:   []OOOPeriod{{Start: local("2026-03-02 00:00"), End: local("2026-03-03 00:00")}},
+			start: "2026-03-02 00:00", end: "2026-03-04 00:00",
+			want: []string{"Tue 09:00-17:00 CET"},
+		},
+		{
+			name:  "working hours keep their clock times across DST",
+			start: "2026-03-27 00:00", end: "2026-03-31 00:00",
+			want: []string{"Fri 09:00-17:00 CET", "Mon 09:00-17:00 CEST"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slots := findFreeSlots(tt.busy, tt.ooo, hours, local(tt.start), local(tt.end), 30*time.Minute)
+
+			var got []string
+			for _, slot := range slots {
+				got = append(got, slot.Start.Format("Mon 15:04-")+slot.End.Format("15:04 MST"))
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,101 +21,42 @@ Supports all the same filters as the main command.`,
 	RunE: runNext,
 }
 
+var (
+	nextOutput string
+	nextFormat string
+)
+
 func init() {
 	rootCmd.AddCommand(nextCmd)
 	// All filter flags are inherited from root as persistent flags
+	nextCmd.Flags().StringVarP(&nextOutput, "output", "o", "text", "Output format: text, json, jsonl, csv, tsv or yaml")
+	nextCmd.Flags().StringVar(&nextFormat, "format", "", "Go template for each event, or the name of a template from the config")
 }
 
 func runNext(cmd *cobra.Command, args []string) error {
-	// Read from viper to get profile settings, with flag overrides
-	calendars := viper.GetString("calendars")
-	showOOO := viper.GetBool("ooo")
-	showFocus := viper.GetBool("focus")
-	showWorkLoc := viper.GetBool("workloc")
-	allTypes := viper.GetBool("all_types")
-	onlyAccepted := viper.GetBool("accepted")
-	showSubscribed := viper.GetBool("subscribed")
-	smartOOO := viper.GetBool("smart_ooo")
-	primaryCalendar := viper.GetString("primary_calendar")
-	noAllDay := viper.GetBool("no_allday")
-
-	now := time.Now()
-	var start, end time.Time
-
-	// Determine date range
-	fromStr := viper.GetString("from")
-	toStr := viper.GetString("to")
-
-	if fromStr != "" || toStr != "" {
-		if fromStr != "" {
-			var err error
-			start, err = parseDate(fromStr, now)
-			if err != nil {
-				return err
-			}
-		} else {
-			start = now
-		}
-
-		if toStr != "" {
-			var err error
-			end, err = parseDate(toStr, now)
-			if err != nil {
-				return err
-			}
-			end = end.Add(24*time.Hour - time.Second)
-		} else {
-			days := viper.GetInt("days")
-			end = start.Add(time.Duration(days) * 24 * time.Hour)
-		}
-	} else {
-		days := viper.GetInt("days")
-		start = now
-		end = now.Add(time.Duration(days) * 24 * time.Hour)
+	if err := checkOutputFormat(nextOutput); err != nil {
+		return err
 	}
-
-	opts := core.FetchOptions{
-		Start: start,
-		End:   end,
-	}
-
-	// Calendar filter
-	if calendars != "" {
-		filterNames := strings.Split(calendars, ",")
-		calendarIDs := resolveCalendarNames(filterNames, adapter.Calendars())
-		if len(calendarIDs) == 0 {
-			return fmt.Errorf("no matching calendars found for: %s\nUse 'tsk calendars' to see available calendars", calendars)
+	var tmpl *template.Template
+	if nextFormat != "" {
+		if nextOutput != "text" {
+			return fmt.Errorf("choose one of --format or --output")
+		}
+		var err error
+		if tmpl, err = parseEventTemplate(nextFormat); err != nil {
+			return err
 		}
-		opts.CalendarIDs = calendarIDs
 	}
 
-	// Event type filter
-	if allTypes {
-		opts.IncludeTypes = []core.EventType{
-			core.TypeDefault,
-			core.TypeOutOfOffice,
-			core.TypeFocusTime,
-			core.TypeWorkLocation,
-		}
-	} else {
-		opts.IncludeTypes = []core.EventType{core.TypeDefault}
-		if showOOO {
-			opts.IncludeTypes = append(opts.IncludeTypes, core.TypeOutOfOffice)
-		}
-		if showFocus {
-			opts.IncludeTypes = append(opts.IncludeTypes, core.TypeFocusTime)
-		}
-		if showWorkLoc {
-			opts.IncludeTypes = append(opts.IncludeTypes, core.TypeWorkLocation)
-		}
+	now := time.Now()
+	start, end, err := dateRange(now)
+	if err != nil {
+		return err
 	}
 
-	// Status filter
-	if onlyAccepted {
-		opts.IncludeStatuses = []core.EventStatus{core.StatusAccepted}
-		if showSubscribed {
-			opts.IncludeStatuses = append(opts.IncludeStatuses, core.StatusNoResponse)
-		}
+	opts, err := filterOptions(start, end)
+	if err != nil {
+		return err
 	}
 
 	events, err := adapter.FetchEvents(cmd.Context(), opts)
@@ -122,44 +65,52 @@ func runNext(cmd *cobra.Command, args []string) error {
 	}
 
 	// Apply smart OOO filter
-	if smartOOO {
-		primaryID := detectPrimaryCalendar(primaryCalendar)
+	if viper.GetBool("smart_ooo") {
+		primaryID := detectPrimaryCalendar(viper.GetString("primary_calendar"))
 		oooPeriods := getOOOPeriods(cmd.Context(), now, end, primaryID)
 		if len(oooPeriods) > 0 {
 			events = filterEventsOutsideOOO(events, oooPeriods, primaryID)
 		}
 	}
 
-	// Find eligible events (filter out all-day if needed, skip past events)
+	// Find eligible events (skip past events)
 	var eligible []core.Event
 	for _, e := range events {
-		// Skip all-day events if --no-allday is set
-		if noAllDay && e.IsAllDay {
-			continue
-		}
-
 		// Include events that start after now, or are in progress (and not all-day)
 		if e.Start.After(now) || (e.InProgress(now) && !e.IsAllDay) {
 			eligible = append(eligible, e)
 		}
 	}
 
-	if len(eligible) == 0 {
-		fmt.Println("No upcoming events found.")
-		return nil
-	}
-
 	// Find all events that start at the same time as the first eligible event
-	nextStart := eligible[0].Start
 	var concurrent []core.Event
 	for _, e := range eligible {
-		if e.Start.Equal(nextStart) {
+		if e.Start.Equal(eligible[0].Start) {
 			concurrent = append(concurrent, e)
 		} else {
 			break // Events are sorted by start time
 		}
 	}
 
+	if nextOutput != "text" || tmpl != nil {
+		if notice := offlineNotice(start, end); notice != "" {
+			fmt.Fprintln(os.Stderr, notice)
+		}
+		if tmpl != nil {
+			return renderEvents(os.Stdout, tmpl, concurrent)
+		}
+		return writeEvents(os.Stdout, nextOutput, concurrent)
+	}
+
+	if notice := offlineNotice(start, end); notice != "" {
+		fmt.Println(notice)
+	}
+
+	if len(concurrent) == 0 {
+		fmt.Println("No upcoming events found.")
+		return nil
+	}
+
 	// Show conflict warning if multiple events at the same time
 	if len(concurrent) > 1 {
 		printConcurrentEvents(concurrent, now)
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// outputFormats are the values accepted by --output on listing commands.
+var outputFormats = []string{"text", "json", "jsonl", "csv", "tsv", "yaml"}
+
+// checkOutputFormat validates an --output value.
+func checkOutputFormat(format string) error {
+	for _, f := range outputFormats {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid --output %q (use %s)", format, strings.Join(outputFormats, ", "))
+}
+
+// EventRecord is the structured form of an event printed by --output.
+// The field names are a stable interface: add fields, don't rename them.
+type EventRecord struct {
+	ID               string             `json:"id" yaml:"id"`
+	UID              string             `json:"uid" yaml:"uid"`
+	Provider         string             `json:"provider" yaml:"provider"`
+	CalendarID       string             `json:"calendar_id" yaml:"calendar_id"`
+	Calendar         string             `json:"calendar" yaml:"calendar"`
+	Calendars        []CalendarRecord   `json:"calendars" yaml:"calendars"`
+	Type             string             `json:"type" yaml:"type"`
+	Status           string             `json:"status" yaml:"status"`
+	Title            string             `json:"title" yaml:"title"`
+	Description      string             `json:"description" yaml:"description"`
+	Location         string             `json:"location" yaml:"location"`
+	Start            string             `json:"start" yaml:"start"`
+	End              string             `json:"end" yaml:"end"`
+	AllDay           bool               `json:"all_day" yaml:"all_day"`
+	DurationMinutes  int                `json:"duration_minutes" yaml:"duration_minutes"`
+	URL              string             `json:"url" yaml:"url"`
+	MeetingLink      string             `json:"meeting_link" yaml:"meeting_link"`
+	Organizer        string             `json:"organizer" yaml:"organizer"`
+	OrganizerName    string             `json:"organizer_name" yaml:"organizer_name"`
+	Attendees        []AttendeeRecord   `json:"attendees" yaml:"attendees"`
+	Attachments      []AttachmentRecord `json:"attachments" yaml:"attachments"`
+	RecurringEventID string             `json:"recurring_event_id" yaml:"recurring_event_id"`
+}
+
+// CalendarRecord is a calendar in structured output. Status and URL are
+// only set for the calendars an event appears in.
+type CalendarRecord struct {
+	ID     string `json:"id" yaml:"id"`
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
+	URL    string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+// AttendeeRecord is an attendee in structured output.
+type AttendeeRecord struct {
+	Name     string `json:"name" yaml:"name"`
+	Email    string `json:"email" yaml:"email"`
+	Optional bool   `json:"optional" yaml:"optional"`
+	Resource bool   `json:"resource" yaml:"resource"`
+	Response string `json:"response" yaml:"response"`
+}
+
+// AttachmentRecord is an attachment in structured output.
+type AttachmentRecord struct {
+	Name     string `json:"name" yaml:"name"`
+	URL      string `json:"url" yaml:"url"`
+	MimeType string `json:"mime_type" yaml:"mime_type"`
+}
+
+// eventRecordColumns are the columns of CSV and TSV output; lists are
+// flattened (attendees as "; "-separated emails).
+var eventRecordColumns = []string{
+	"id", "calendar_id", "calendar", "type", "status", "title",
+	"start", "end", "all_day", "duration_minutes", "location",
+	"meeting_link", "url", "organizer", "attendees", "description",
+}
+
+// newEventRecord converts an event to its structured form. Times are
+// RFC 3339; all-day events use dates, with an exclusive end.
+func newEventRecord(e core.Event) EventRecord {
+	r := EventRecord{
+		ID:               e.ID,
+		UID:              e.DedupeKey,
+		Provider:         e.ProviderID,
+		CalendarID:       e.Calendar.ID,
+		Calendar:         e.Calendar.Name,
+		Calendars:        []CalendarRecord{},
+		Type:             eventTypeName(e.Type),
+		Status:           statusName(e.Status),
+		Title:            e.Title,
+		Description:      e.Description,
+		Location:         e.Location,
+		AllDay:           e.IsAllDay,
+		DurationMinutes:  int(e.Duration().Minutes()),
+		URL:              e.URL,
+		MeetingLink:      e.MeetingLink,
+		Organizer:        e.Organizer,
+		OrganizerName:    e.OrganizerName,
+		Attendees:        []AttendeeRecord{},
+		Attachments:      []AttachmentRecord{},
+		RecurringEventID: e.RecurringEventID,
+	}
+
+	if e.IsAllDay {
+		r.Start = e.Start.Format("2006-01-02")
+		r.End = e.End.Format("2006-01-02")
+	} else {
+		r.Start = e.Start.Local().Format(time.RFC3339)
+		r.End = e.End.Local().Format(time.RFC3339)
+	}
+
+	for _, c := range e.Calendars {
+		r.Calendars = append(r.Calendars, CalendarRecord{
+			ID:     c.Calendar.ID,
+			Name:   c.Calendar.Name,
+			Status: statusName(c.Status),
+			URL:    c.URL,
+		})
+	}
+	for _, a := range e.Attendees {
+		r.Attendees = append(r.Attendees, AttendeeRecord{
+			Name:     a.Name,
+			Email:    a.Email,
+			Optional: a.Optional,
+			Resource: a.IsResource,
+			Response: statusName(a.Response),
+		})
+	}
+	for _, a := range e.Attachments {
+		r.Attachments = append(r.Attachments, AttachmentRecord{
+			Name:     a.Name,
+			URL:      a.URL,
+			MimeType: a.MimeType,
+		})
+	}
+	return r
+}
+
+// row returns the record's CSV/TSV columns, in eventRecordColumns order.
+func (r EventRecord) row() []string {
+	var emails []string
+	for _, a := range r.Attendees {
+		emails = append(emails, a.Email)
+	}
+	return []string{
+		r.ID, r.CalendarID, r.Calendar, r.Type, r.Status, r.Title,
+		r.Start, r.End, strconv.FormatBool(r.AllDay), strconv.Itoa(r.DurationMinutes), r.Location,
+		r.MeetingLink, r.URL, r.Organizer, strings.Join(emails, "; "), r.Description,
+	}
+}
+
+// statusName is the word used for a response status in structured output.
+func statusName(s core.EventStatus) string {
+	switch s {
+	case core.StatusAccepted:
+		return "accepted"
+	case core.StatusRejected:
+		return "declined"
+	case core.StatusTentative:
+		return "tentative"
+	case core.StatusAwaiting:
+		return "awaiting"
+	default:
+		return "none"
+	}
+}
+
+// eventTypeName is the word used for an event type in structured output.
+func eventTypeName(t core.EventType) string {
+	switch t {
+	case core.TypeOutOfOffice:
+		return "out_of_office"
+	case core.TypeFocusTime:
+		return "focus_time"
+	case core.TypeWorkLocation:
+		return "working_location"
+	default:
+		return "default"
+	}
+}
+
+// writeEvents prints events in a structured format.
+func writeEvents(w io.Writer, format string, events []core.Event) error {
+	records := make([]EventRecord, 0, len(events))
+	for _, e := range events {
+		records = append(records, newEventRecord(e))
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		rows = append(rows, r.row())
+	}
+	return writeRecords(w, format, records, eventRecordColumns, rows)
+}
+
+// writeCalendars prints calendars (ID -> name) in a structured format,
+// sorted by name.
+func writeCalendars(w io.Writer, format string, calendars map[string]string) error {
+	records := make([]CalendarRecord, 0, len(calendars))
+	for id, name := range calendars {
+		records = append(records, CalendarRecord{ID: id, Name: name})
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		return records[i].ID < records[j].ID
+	})
+
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		rows = append(rows, []string{r.ID, r.Name})
+	}
+	return writeRecords(w, format, records, []string{"id", "name"}, rows)
+}
+
+// writeRecords prints records (a slice) as JSON, JSON lines or YAML, or
+// rows under a header as CSV or TSV.
+func writeRecords[T any](w io.Writer, format string, records []T, header []string, rows [][]string) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case "jsonl":
+		encoder := json.NewEncoder(w)
+		for _, r := range records {
+			if err := encoder.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "yaml":
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(records); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case "csv":
+		writer := csv.NewWriter(w)
+		_ = writer.Write(header)
+		_ = writer.WriteAll(rows)
+		return writer.Error()
+	case "tsv":
+		// No quoting in TSV: tabs and line breaks inside a field become spaces
+		clean := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, row := range rows {
+			fields := make([]string, len(row))
+			for i, f := range row {
+				fields[i] = clean.Replace(f)
+			}
+			fmt.Fprintln(w, strings.Join(fields, "\t"))
+		}
+		return nil
+	default:
+		return checkOutputFormat(format)
+	}
+}
@@ -16,7 +16,8 @@ var profileCmd = &cobra.Command{
 	Long: `Manage configuration profiles for different accounts and filter presets.
 
 Profiles allow you to quickly switch between different calendar providers
-(Google Calendar, Outlook/Office 365) and filter configurations.`,
+(Google Calendar, Outlook/Office 365, CalDAV, local .ics files, subscribed feeds)
+and filter configurations.`,
 }
 
 var profileListCmd = &cobra.Command{
@@ -67,9 +68,13 @@ func init() {
 	profileCmd.AddCommand(profileEditCmd)
 
 	// Flags for add command - provider
-	profileAddCmd.Flags().String("provider", "google", "Calendar provider (google, outlook)")
+	profileAddCmd.Flags().String("provider", "google", "Calendar provider (google, outlook, caldav, ics, ics_url)")
 	profileAddCmd.Flags().String("client-id", "", "Azure AD application client ID (Outlook)")
 	profileAddCmd.Flags().String("tenant-id", "common", "Azure AD tenant ID (Outlook)")
+	profileAddCmd.Flags().String("url", "", "Server URL (CalDAV) or feed URL (ics_url)")
+	profileAddCmd.Flags().String("username", "", "Username (CalDAV)")
+	profileAddCmd.Flags().String("email", "", "Your attendee email address, if not the username (CalDAV, ics, ics_url)")
+	profileAddCmd.Flags().String("path", "", ".ics file or vdir directory (ics)")
 
 	// Flags for add command - filters
 	profileAddCmd.Flags().String("credentials-file", "", "Path to credentials file (Google)")
@@ -98,9 +103,13 @@ func init() {
 	profileAddCmd.Flags().Bool("show-in-progress", true, "Show in-progress status")
 
 	// Same flags for edit command - provider
-	profileEditCmd.Flags().String("provider", "", "Calendar provider (google, outlook)")
+	profileEditCmd.Flags().String("provider", "", "Calendar provider (google, outlook, caldav, ics, ics_url)")
 	profileEditCmd.Flags().String("client-id", "", "Azure AD application client ID (Outlook)")
 	profileEditCmd.Flags().String("tenant-id", "", "Azure AD tenant ID (Outlook)")
+	profileEditCmd.Flags().String("url", "", "Server URL (CalDAV) or feed URL (ics_url)")
+	profileEditCmd.Flags().String("username", "", "Username (CalDAV)")
+	profileEditCmd.Flags().String("email", "", "Your attendee email address, if not the username (CalDAV, ics, ics_url)")
+	profileEditCmd.Flags().String("path", "", ".ics file or vdir directory (ics)")
 
 	// Same flags for edit command - filters
 	profileEditCmd.Flags().String("credentials-file", "", "Path to credentials file (Google)")
@@ -192,6 +201,11 @@ func runProfileShow(cmd *cobra.Command, args []string) error {
 	printSetting(settings, "client_id", "client-id")
 	printSetting(settings, "tenant_id", "tenant-id")
 	printSetting(settings, "token_file", "token-file")
+	printSetting(settings, "url", "url")
+	printSetting(settings, "urls", "urls")
+	printSetting(settings, "username", "username")
+	printSetting(settings, "email", "email")
+	printSetting(settings, "path", "path")
 
 	fmt.Println("\n📅 Time Range:")
 	printSetting(settings, "primary_calendar", "primary-calendar")
@@ -260,6 +274,11 @@ func runProfileAdd(cmd *cobra.Command, args []string) error {
 	if val, _ := cmd.Flags().GetString("credentials-file"); val != "" {
 		profile["credentials_file"] = val
 	}
+	for _, key := range []string{"url", "username", "email", "path"} {
+		if val, _ := cmd.Flags().GetString(key); val != "" {
+			profile[key] = val
+		}
+	}
 	if val, _ := cmd.Flags().GetString("token-file"); val != "" {
 		profile["token_file"] = val
 	}
@@ -401,6 +420,12 @@ func runProfileEdit(cmd *cobra.Command, args []string) error {
 		profile["credentials_file"] = val
 		changed = true
 	}
+	for _, key := range []string{"url", "username", "email", "path"} {
+		if val, _ := cmd.Flags().GetString(key); cmd.Flags().Changed(key) {
+			profile[key] = val
+			changed = true
+		}
+	}
 	if val, _ := cmd.Flags().GetString("token-file"); cmd.Flags().Changed("token-file") {
 		profile["token_file"] = val
 		changed = true
@@ -0,0 +1,425 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// quickAdd is an event described in a sentence, e.g.
+// "Lunch with Priya tomorrow 12:30-13:30 at Cafe Mondo @personal".
+type quickAdd struct {
+	Title    string
+	Location string
+	// Calendar is the name given with @calendar
+	Calendar string
+
+	Date    time.Time
+	HasDate bool
+	// Start and End are times of day on Date
+	Start   clock
+	HasTime bool
+	End     clock
+	HasEnd  bool
+	// Duration is set by "for 45m"
+	Duration time.Duration
+	AllDay   bool
+}
+
+// clock is a time of day.
+type clock struct {
+	Hour, Minute int
+}
+
+func (c clock) minutes() int { return c.Hour*60 + c.Minute }
+
+// on returns the clock time on the date of day.
+func (c clock) on(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), c.Hour, c.Minute, 0, 0, time.Local)
+}
+
+// apply sets the draft's time from the sentence. A day without a time is
+// an all-day event; a time without an end lasts defaultDuration.
+func (qa quickAdd) apply(draft *core.EventDraft, defaultDuration time.Duration, now time.Time) {
+	date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if qa.HasDate {
+		date = qa.Date
+	}
+
+	if qa.AllDay || !qa.HasTime {
+		days := 1
+		if qa.Duration > 24*time.Hour {
+			days = int((qa.Duration + 24*time.Hour - 1) / (24 * time.Hour))
+		}
+		draft.IsAllDay = true
+		draft.Start = date
+		draft.End = date.AddDate(0, 0, days)
+		return
+	}
+
+	draft.IsAllDay = false
+	draft.Start = qa.Start.on(date)
+	switch {
+	case qa.HasEnd:
+		draft.End = qa.End.on(date)
+		if !draft.End.After(draft.Start) {
+			draft.End = draft.End.AddDate(0, 0, 1) // runs past midnight
+		}
+	case qa.Duration > 0:
+		draft.End = draft.Start.Add(qa.Duration)
+	default:
+		draft.End = draft.Start.Add(defaultDuration)
+	}
+}
+
+// parseQuickAdd picks the date, time, duration, location and calendar out
+// of a sentence; the remaining words are the title. It understands:
+//
+//	dates      today, tomorrow, friday, next tuesday, next week, 2026-03-04, 03/04 (optionally after "on"; 03-04 only after "on")
+//	times      14:00, 2pm, 9:30am (optionally after "at" or "from")
+//	ranges     12:30-13:30, 2-3pm, 2pm to 3:30pm, from 9am until 11am
+//	durations  for 45m, for 1h30m, for 2 hours, for an hour
+//	all day    all day, all-day
+//	location   at <place>, up to the next recognized phrase
+//	calendar   @work
+func parseQuickAdd(text string, now time.Time) (quickAdd, error) {
+	var qa quickAdd
+	var title, location []string
+	inLocation := false
+
+	words := strings.Fields(text)
+	for i := 0; i < len(words); {
+		if n := qa.match(words, i, now); n > 0 {
+			i += n
+			inLocation = false
+			continue
+		}
+
+		word := words[i]
+		if lower := normalizeWord(word); lower == "at" && i+1 < len(words) && len(location) == 0 {
+			inLocation = true
+			i++
+			continue
+		}
+
+		if inLocation {
+			location = append(location, word)
+		} else {
+			title = append(title, word)
+		}
+		i++
+	}
+
+	qa.Title = strings.TrimRight(strings.Join(title, " "), ",;-–")
+	qa.Location = strings.TrimRight(strings.Join(location, " "), ",;.")
+
+	if qa.Title == "" {
+		return qa, fmt.Errorf("no title found in %q", text)
+	}
+	if !qa.HasDate && !qa.HasTime && !qa.AllDay {
+		return qa, fmt.Errorf("no date or time found in %q\n\nAdd a date or time, or set it with --start:\n  tsk add \"Lunch with Priya tomorrow 12:30-13:30 at Cafe Mondo\"\n  tsk add \"Standup friday 9:30 for 15m @work\"\n  tsk add \"Design review\" --start \"tomorrow 14:00\"", text)
+	}
+	return qa, nil
+}
+
+// match tries to read a recognized phrase at words[i] into qa and returns
+// the number of words it used, or 0.
+func (qa *quickAdd) match(words []string, i int, now time.Time) int {
+	word := normalizeWord(words[i])
+	next := func(k int) string {
+		if i+k < len(words) {
+			return normalizeWord(words[i+k])
+		}
+		return ""
+	}
+
+	// @calendar
+	if strings.HasPrefix(words[i], "@") && len(words[i]) > 1 && qa.Calendar == "" {
+		qa.Calendar = strings.TrimRight(words[i][1:], ",;.")
+		return 1
+	}
+
+	// all day
+	if word == "all-day" || word == "allday" {
+		qa.AllDay = true
+		return 1
+	}
+	if word == "all" && next(1) == "day" {
+		qa.AllDay = true
+		return 2
+	}
+
+	// for <duration>
+	if word == "for" {
+		if d, n := parseSpokenDuration(words[i+1:]); n > 0 && qa.Duration == 0 {
+			qa.Duration = d
+			return n + 1
+		}
+		return 0
+	}
+
+	// "on" is followed by a date, and only there is "10-11" October 11
+	if word == "on" {
+		if qa.HasDate {
+			return 0
+		}
+		date, n := parseDatePhrase(words[i+1:], now)
+		if n == 0 {
+			return 0
+		}
+		qa.Date = date
+		qa.HasDate = true
+		return n + 1
+	}
+
+	// "at" and "from" belong to the time after them
+	skip := 0
+	if word == "at" || word == "from" {
+		if _, _, n := parseTimePhrase(words[i+1:]); n == 0 {
+			return 0
+		}
+		skip = 1
+	}
+
+	// Times go first, so "Standup 10-11" is a range rather than a date
+	if !qa.HasTime {
+		if start, end, n := parseTimePhrase(words[i+skip:]); n > 0 {
+			qa.Start = start
+			qa.HasTime = true
+			if end != nil {
+				qa.End = *end
+				qa.HasEnd = true
+			}
+			return skip + n
+		}
+	}
+
+	if skip == 0 && !qa.HasDate {
+		if date, n := parseDatePhrase(words[i:], now); n > 0 {
+			qa.Date = date
+			qa.HasDate = true
+			return n
+		}
+	}
+
+	return 0
+}
+
+// parseDatePhrase reads a date of up to two words ("next tuesday") with
+// parseDate.
+func parseDatePhrase(words []string, now time.Time) (time.Time, int) {
+	for n := 2; n >= 1; n-- {
+		if len(words) < n {
+			continue
+		}
+		phrase := make([]string, n)
+		for k := range phrase {
+			phrase[k] = normalizeWord(words[k])
+		}
+		if !looksLikeDate(phrase) {
+			continue
+		}
+		if date, err := parseDate(strings.Join(phrase, " "), now); err == nil {
+			return date, n
+		}
+	}
+	return time.Time{}, 0
+}
+
+// looksLikeDate keeps parseDate from reading title words: a date phrase
+// is a date word, or "next" followed by one, or contains a digit.
+func looksLikeDate(phrase []string) bool {
+	if len(phrase) == 2 && phrase[0] != "next" {
+		return false
+	}
+	last := phrase[len(phrase)-1]
+	return strings.ContainsAny(last, "0123456789") || len(phrase) == 2 || dateWords[last]
+}
+
+var dateWords = map[string]bool{
+	"today": true, "tomorrow": true, "yesterday": true,
+	"sunday": true, "sun": true, "monday": true, "mon": true,
+	"tuesday": true, "tue": true, "wednesday": true, "wed": true,
+	"thursday": true, "thu": true, "friday": true, "fri": true,
+	"saturday": true, "sat": true,
+}
+
+// parseTimePhrase reads a time or time range: "14:00", "2-3pm",
+// "12:30-13:30", "2pm to 3pm", "9am until 11am". end is nil for a single
+// time.
+func parseTimePhrase(words []string) (start clock, end *clock, n int) {
+	if len(words) == 0 {
+		return clock{}, nil, 0
+	}
+	first := normalizeWord(words[0])
+
+	// "9:30 pm" is one time
+	if len(words) > 1 && isMeridiem(normalizeWord(words[1])) {
+		if _, _, ok := parseClock(first + normalizeWord(words[1])); ok {
+			first += normalizeWord(words[1])
+			n = 1
+		}
+	}
+
+	// One word: "12:30-13:30" or "2-3pm"
+	if from, to, ok := strings.Cut(strings.ReplaceAll(first, "–", "-"), "-"); ok {
+		if s, e, ok := parseClockRange(from, to); ok {
+			return s, &e, n + 1
+		}
+		return clock{}, nil, 0
+	}
+
+	h, m, ok := parseClock(first)
+	if !ok {
+		return clock{}, nil, 0
+	}
+	start = clock{h, m}
+	n++
+
+	// "2pm to 3pm", "2pm - 3pm", "9am until 11am"
+	if len(words) > n+1 {
+		switch normalizeWord(words[n]) {
+		case "to", "until", "till", "-", "–":
+			rest := words[n+1:]
+			to := normalizeWord(rest[0])
+			used := 1
+			if len(rest) > 1 && isMeridiem(normalizeWord(rest[1])) {
+				to += normalizeWord(rest[1])
+				used = 2
+			}
+			if s, e, ok := parseClockRange(first, to); ok {
+				return s, &e, n + 1 + used
+			}
+		}
+	}
+
+	return start, nil, n
+}
+
+// parseClockRange parses the two ends of a range. An end with am/pm lends
+// it to a start without ("2-3pm"); a bare hour counts as a time here.
+func parseClockRange(from, to string) (start, end clock, ok bool) {
+	eh, em, ok := parseClockOrHour(to)
+	if !ok {
+		return clock{}, clock{}, false
+	}
+	end = clock{eh, em}
+
+	if sh, sm, ok := parseClock(from); ok {
+		return clock{sh, sm}, end, true
+	}
+
+	// Borrow the end's am/pm, or the other one if that puts start after end
+	if suffix := meridiem(to); suffix != "" {
+		for _, s := range []string{suffix, otherMeridiem(suffix)} {
+			if sh, sm, ok := parseClock(from + s); ok && (clock{sh, sm}).minutes() < end.minutes() {
+				return clock{sh, sm}, end, true
+			}
+		}
+		return clock{}, clock{}, false
+	}
+
+	sh, sm, ok := parseClockOrHour(from)
+	if !ok {
+		return clock{}, clock{}, false
+	}
+	return clock{sh, sm}, end, true
+}
+
+// parseClockOrHour is parseClock, also accepting a bare hour (0-23).
+func parseClockOrHour(s string) (hour, minute int, ok bool) {
+	if hour, minute, ok = parseClock(s); ok {
+		return hour, minute, true
+	}
+	hour, err := strconv.Atoi(s)
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, 0, false
+	}
+	return hour, 0, true
+}
+
+func isMeridiem(s string) bool {
+	return s == "am" || s == "pm"
+}
+
+func meridiem(s string) string {
+	if len(s) > 2 && isMeridiem(s[len(s)-2:]) {
+		return s[len(s)-2:]
+	}
+	return ""
+}
+
+func otherMeridiem(s string) string {
+	if s == "am" {
+		return "pm"
+	}
+	return "am"
+}
+
+// parseSpokenDuration reads a duration: "45m", "1h30m", "90 min",
+// "2 hours", "1.5h", "an hour", "half an hour".
+func parseSpokenDuration(words []string) (time.Duration, int) {
+	if len(words) == 0 {
+		return 0, 0
+	}
+	first := normalizeWord(words[0])
+
+	switch {
+	case (first == "an" || first == "a") && len(words) > 1 && isUnit(normalizeWord(words[1]), "h"):
+		return time.Hour, 2
+	case first == "a" && len(words) > 1 && isUnit(normalizeWord(words[1]), "d"):
+		return 24 * time.Hour, 2
+	case first == "half" && len(words) > 2 && normalizeWord(words[1]) == "an" && isUnit(normalizeWord(words[2]), "h"):
+		return 30 * time.Minute, 3
+	}
+
+	// "3d"
+	if days, err := strconv.Atoi(strings.TrimSuffix(first, "d")); err == nil && days > 0 && strings.HasSuffix(first, "d") {
+		return time.Duration(days) * 24 * time.Hour, 1
+	}
+
+	// "45m", "1h30m", "1.5h"
+	if d, err := time.ParseDuration(strings.TrimSuffix(strings.TrimSuffix(first, "in"), "ins")); err == nil && d > 0 {
+		return d, 1
+	}
+
+	// "45 minutes", "2 hours", "1.5 hrs", "3 days"
+	if len(words) > 1 {
+		value, err := strconv.ParseFloat(first, 64)
+		if err != nil || value <= 0 {
+			return 0, 0
+		}
+		unit := normalizeWord(words[1])
+		switch {
+		case isUnit(unit, "h"):
+			return time.Duration(value * float64(time.Hour)), 2
+		case isUnit(unit, "m"):
+			return time.Duration(value * float64(time.Minute)), 2
+		case isUnit(unit, "d"):
+			return time.Duration(value * float64(24*time.Hour)), 2
+		}
+	}
+	return 0, 0
+}
+
+// isUnit reports whether word is a spelling of hours ("h"), minutes ("m")
+// or days ("d").
+func isUnit(word, unit string) bool {
+	switch unit {
+	case "h":
+		return word == "h" || word == "hr" || word == "hrs" || word == "hour" || word == "hours"
+	case "m":
+		return word == "m" || word == "min" || word == "mins" || word == "minute" || word == "minutes"
+	case "d":
+		return word == "d" || word == "day" || word == "days"
+	}
+	return false
+}
+
+// normalizeWord lower-cases a word and strips surrounding punctuation.
+func normalizeWord(word string) string {
+	return strings.Trim(strings.ToLower(word), ",;.!?()\"'")
+}
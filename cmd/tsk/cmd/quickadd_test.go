@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	friday := today.AddDate(0, 0, int(time.Friday-today.Weekday()))
+	if !friday.After(today) {
+		friday = friday.AddDate(0, 0, 7)
+	}
+
+	tests := []struct {
+		text     string
+		title    string
+		location string
+		calendar string
+		date     time.Time // zero for no date
+		start    *clock
+		end      *clock
+		duration time.Duration
+		allDay   bool
+	}{
+		{text: "Standup today 9:30", title: "Standup", date: today, start: &clock{9, 30}},
+		{text: "Dentist tomorrow 2pm", title: "Dentist", date: today.AddDate(0, 0, 1), start: &clock{14, 0}},
+		{text: "Retro friday 3 pm", title: "Retro", date: friday, start: &clock{15, 0}},
+		{text: "Launch on 2026-03-04", title: "Launch", date: time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local)},
+		{text: "Launch 03/04", title: "Launch", date: time.Date(now.Year(), 3, 4, 0, 0, 0, 0, time.Local)},
+		{text: "Launch on 03-04", title: "Launch", date: time.Date(now.Year(), 3, 4, 0, 0, 0, 0, time.Local)},
+		{text: "Lunch 12:30-13:30", title: "Lunch", start: &clock{12, 30}, end: &clock{13, 30}},
+		{text: "Call 2-3pm", title: "Call", start: &clock{14, 0}, end: &clock{15, 0}},
+		{text: "Call 2pm to 3:30pm", title: "Call", start: &clock{14, 0}, end: &clock{15, 30}},
+		{text: "Workshop from 9am until 11am", title: "Workshop", start: &clock{9, 0}, end: &clock{11, 0}},
+		{text: "Standup 10-11 tomorrow", title: "Standup", date: today.AddDate(0, 0, 1), start: &clock{10, 0}, end: &clock{11, 0}},
+		{text: "Lunch 12-13 friday", title: "Lunch", date: friday, start: &clock{12, 0}, end: &clock{13, 0}},
+		{text: "Sync tomorrow 10:00 for 45m", title: "Sync", date: today.AddDate(0, 0, 1), start: &clock{10, 0}, duration: 45 * time.Minute},
+		{text: "Review at 3pm for 1h30m", title: "Review", start: &clock{15, 0}, duration: 90 * time.Minute},
+		{text: "Offsite tomorrow for 3 days", title: "Offsite", date: today.AddDate(0, 0, 1), duration: 72 * time.Hour},
+		{text: "Holiday friday all day", title: "Holiday", date: friday, allDay: true},
+		{text: "Lunch with Priya tomorrow 12:30 at Cafe Mondo", title: "Lunch with Priya", location: "Cafe Mondo", date: today.AddDate(0, 0, 1), start: &clock{12, 30}},
+		{text: "Coffee at Blue Bottle tomorrow 9am @personal", title: "Coffee", location: "Blue Bottle", calendar: "personal", date: today.AddDate(0, 0, 1), start: &clock{9, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			qa, err := parseQuickAdd(tt.text, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if qa.Title != tt.title {
+				t.Errorf("title = %q, want %q", qa.Title, tt.title)
+			}
+			if qa.Location != tt.location {
+				t.Errorf("location = %q, want %q", qa.Location, tt.location)
+			}
+			if qa.Calendar != tt.calendar {
+				t.Errorf("calendar = %q, want %q", qa.Calendar, tt.calendar)
+			}
+			if qa.HasDate != !tt.date.IsZero() || qa.HasDate && !qa.Date.Equal(tt.date) {
+				t.Errorf("date = %v (set: %v), want %v", qa.Date, qa.HasDate, tt.date)
+			}
+			if qa.HasTime != (tt.start != nil) || qa.HasTime && qa.Start != *tt.start {
+				t.Errorf("start = %v (set: %v), want %v", qa.Start, qa.HasTime, tt.start)
+			}
+			if qa.HasEnd != (tt.end != nil) || qa.HasEnd && qa.End != *tt.end {
+				t.Errorf("end = %v (set: %v), want %v", qa.End, qa.HasEnd, tt.end)
+			}
+			if qa.Duration != tt.duration {
+				t.Errorf("duration = %v, want %v", qa.Duration, tt.duration)
+			}
+			if qa.AllDay != tt.allDay {
+				t.Errorf("all day = %v, want %v", qa.AllDay, tt.allDay)
+			}
+		})
+	}
+}
+
+func TestParseQuickAddErrors(t *testing.T) {
+	for _, text := range []string{
+		"Standup",      // no date or time
+		"tomorrow 9am", // no title
+	} {
+		if _, err := parseQuickAdd(text, time.Now()); err == nil {
+			t.Errorf("%q: expected an error", text)
+		}
+	}
+}
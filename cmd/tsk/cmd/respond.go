@@ -11,12 +11,13 @@ import (
 )
 
 var (
-	respondAccept      bool
-	respondDecline     bool
-	respondTentative   bool
-	respondMessage     string
-	respondPropose     string
+	respondAccept       bool
+	respondDecline      bool
+	respondTentative    bool
+	respondMessage      string
+	respondPropose      string
 	respondAllInstances bool
+	respondScope        string
 )
 
 var respondCmd = &cobra.Command{
@@ -56,9 +57,13 @@ Examples:
   # Decline all instances of a recurring event
   tsk respond primary:abc123 --decline --all-instances
 
+  # Decline this instance and every later one
+  tsk respond primary:abc123 --decline --scope following
+
 Recurring events:
-  By default, responds to a single instance. Use --all-instances to respond to
-  all occurrences of a recurring event series.
+  By default, responds to a single instance. Use --scope following to respond
+  to this and every later occurrence, or --scope all (--all-instances) to
+  respond to all occurrences of a recurring event series.
 
 Note: Currently only supported for Google Calendar. Outlook support coming soon.`,
 	Args: cobra.ExactArgs(1),
@@ -73,6 +78,7 @@ func init() {
 	respondCmd.Flags().StringVarP(&respondMessage, "message", "m", "", "Optional message to organizer")
 	respondCmd.Flags().StringVar(&respondPropose, "propose", "", "Propose new time (format: start/end in RFC3339)")
 	respondCmd.Flags().BoolVar(&respondAllInstances, "all-instances", false, "Respond to all instances of a recurring event")
+	respondCmd.Flags().StringVar(&respondScope, "scope", "", "Instances of a recurring event to respond to: this, following or all")
 }
 
 func runRespond(cmd *cobra.Command, args []string) error {
@@ -107,9 +113,9 @@ func runRespond(cmd *cobra.Command, args []string) error {
 	}
 
 	// Build response options
-	recurringScope := core.RecurringScopeThisInstance
-	if respondAllInstances {
-		recurringScope = core.RecurringScopeAllInstances
+	recurringScope, err := parseScope(respondScope, respondAllInstances)
+	if err != nil {
+		return err
 	}
 
 	opts := core.RespondOptions{
@@ -181,6 +187,30 @@ func parseEventReference(ref string) (calendarID, eventID string, err error) {
 	return calendarID, eventID, nil
 }
 
+// parseScope converts a --scope value to a recurring scope. The older
+// --all-instances flag is kept as a shorthand for --scope all.
+func parseScope(scope string, allInstances bool) (core.RecurringScope, error) {
+	var result core.RecurringScope
+	switch strings.ToLower(strings.TrimSpace(scope)) {
+	case "", "this":
+		result = core.RecurringScopeThisInstance
+	case "following":
+		result = core.RecurringScopeThisAndFollowing
+	case "all":
+		result = core.RecurringScopeAllInstances
+	default:
+		return result, fmt.Errorf("invalid --scope %q (use this, following or all)", scope)
+	}
+
+	if allInstances {
+		if scope != "" && result != core.RecurringScopeAllInstances {
+			return result, fmt.Errorf("--all-instances cannot be combined with --scope %s", scope)
+		}
+		result = core.RecurringScopeAllInstances
+	}
+	return result, nil
+}
+
 // parseProposedTime parses a proposed time in the format "start/end".
 // Accepts multiple formats:
 //   - 14:00/15:00 (uses event date, local timezone)
@@ -270,6 +300,8 @@ func formatRespondError(err error) error {
 		return fmt.Errorf("you are not an attendee of this event\n\nYou can only respond to events where you are invited as an attendee")
 	case errors.Is(err, core.ErrIsOrganizer):
 		return fmt.Errorf("you cannot respond to your own event\n\nYou are the organizer of this event")
+	case errors.Is(err, core.ErrOffline):
+		return fmt.Errorf("cannot respond while offline\n\nRun the command again without --offline once you are connected")
 	default:
 		return fmt.Errorf("failed to respond to event: %w", err)
 	}
@@ -294,7 +326,7 @@ func printRespondSuccess(responseType core.ResponseType, opts core.RespondOption
 	fmt.Printf("  Response: %s\n", responseText)
 
 	// Show scope for recurring events
-	if opts.RecurringScope == core.RecurringScopeAllInstances {
+	if opts.RecurringScope != core.RecurringScopeThisInstance {
 		fmt.Printf("  Scope:    %s\n", opts.RecurringScope.String())
 	}
 
@@ -6,28 +6,30 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/adapter/cached"
+	"github.com/theakshaypant/tsk/internal/adapter/caldav"
+	"github.com/theakshaypant/tsk/internal/adapter/composite"
 	"github.com/theakshaypant/tsk/internal/adapter/google"
+	"github.com/theakshaypant/tsk/internal/adapter/ics"
+	"github.com/theakshaypant/tsk/internal/adapter/icsurl"
 	"github.com/theakshaypant/tsk/internal/adapter/outlook"
 	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/storage"
 	"github.com/theakshaypant/tsk/internal/util"
 )
 
-// CalendarAdapter extends core.Provider with login and calendar listing.
-// Both Google and Outlook adapters implement this interface.
-type CalendarAdapter interface {
-	core.Provider
-	Login(ctx context.Context) error
-	Calendars() map[string]string
-}
-
 var (
-	cfgFile string
-	profile string
-	adapter CalendarAdapter
+	cfgFile       string
+	profile       string
+	activeProfile string
+	adapter       core.CalendarAdapter
+	listOutput    string
+	listFormat    string
 )
 
 var rootCmd = &cobra.Command{
@@ -39,6 +41,7 @@ A TUI tool that pulls events from multiple calendar providers and shows them
 in your terminal. Because sometimes you just want to see what's eating your 
 day without opening a browser.`,
 	PersistentPreRunE: initAdapter,
+	PersistentPostRun: waitForCache,
 	RunE:              listEvents,
 }
 
@@ -54,6 +57,7 @@ func init() {
 	// Global flags (inherited by all subcommands)
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/tsk/config.yaml)")
 	rootCmd.PersistentFlags().StringVarP(&profile, "profile", "p", "", "config profile to use (e.g., work, personal)")
+	rootCmd.PersistentFlags().Bool("offline", false, "Serve events from the local cache without contacting the provider")
 
 	// Filter flags (persistent - inherited by next, calendars, etc.)
 	rootCmd.PersistentFlags().IntP("days", "d", 7, "Number of days to fetch (ignored if --from/--to specified)")
@@ -70,7 +74,12 @@ func init() {
 	rootCmd.PersistentFlags().String("primary-calendar", "", "Primary calendar for smart OOO detection (default: auto-detect)")
 	rootCmd.PersistentFlags().Bool("no-allday", false, "Exclude all-day events")
 
+	// Output format of the event list (not inherited)
+	rootCmd.Flags().StringVarP(&listOutput, "output", "o", "text", "Output format: text, json, jsonl, csv, tsv or yaml")
+	rootCmd.Flags().StringVar(&listFormat, "format", "", "Go template for each event, or the name of a template from the config")
+
 	// Bind persistent flags to viper
+	viper.BindPFlag("offline", rootCmd.PersistentFlags().Lookup("offline"))
 	viper.BindPFlag("days", rootCmd.PersistentFlags().Lookup("days"))
 	viper.BindPFlag("from", rootCmd.PersistentFlags().Lookup("from"))
 	viper.BindPFlag("to", rootCmd.PersistentFlags().Lookup("to"))
@@ -110,6 +119,12 @@ func initConfig() {
 	viper.SetDefault("ooo", true)
 	viper.SetDefault("accepted", true)
 	viper.SetDefault("subscribed", true)
+	viper.SetDefault("cache.enabled", true)
+	viper.SetDefault("cache.dir", "~/.cache/tsk")
+	viper.SetDefault("cache.refresh_interval", cached.DefaultRefreshInterval)
+	viper.SetDefault("working_hours.start", "09:00")
+	viper.SetDefault("working_hours.end", "17:00")
+	viper.SetDefault("working_hours.days", []string{"mon", "tue", "wed", "thu", "fri"})
 
 	// Read config file if it exists
 	if err := viper.ReadInConfig(); err == nil {
@@ -123,7 +138,7 @@ func initConfig() {
 // applyProfile merges profile-specific settings over defaults
 func applyProfile() {
 	// Check for profile from flag or env var
-	activeProfile := profile
+	activeProfile = profile
 	if activeProfile == "" {
 		activeProfile = viper.GetString("default_profile")
 	}
@@ -147,6 +162,13 @@ func applyProfile() {
 		"token_file",
 		"client_id",
 		"tenant_id",
+		"url",
+		"urls",
+		"username",
+		"password",
+		"bearer_token",
+		"email",
+		"path",
 		"days",
 		"from",
 		"to",
@@ -162,7 +184,7 @@ func applyProfile() {
 		"no_allday",
 	}
 
-	// Display settings that can be overridden
+	// Display and working hours settings that can be overridden
 	displaySettings := []string{
 		"display.calendar",
 		"display.time",
@@ -170,10 +192,14 @@ func applyProfile() {
 		"display.meeting_link",
 		"display.description",
 		"display.status",
+		"display.attendees",
 		"display.event_url",
 		"display.attachments",
 		"display.id",
 		"display.in_progress",
+		"working_hours.start",
+		"working_hours.end",
+		"working_hours.days",
 	}
 
 	// Override each setting if present in profile,
@@ -185,7 +211,7 @@ func applyProfile() {
 		}
 	}
 
-	// Override display settings if present in profile
+	// Override display and working hours settings if present in profile
 	for _, key := range displaySettings {
 		profileSettingKey := profileKey + "." + key
 		if viper.IsSet(profileSettingKey) {
@@ -208,116 +234,458 @@ func initAdapter(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	accounts, err := profileAccounts()
+	if err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		adapter, err = newAccountAdapter(currentAccount())
+		if err != nil {
+			return err
+		}
+	} else {
+		// Several accounts in one view
+		var adapters []core.CalendarAdapter
+		for _, acc := range accounts {
+			a, err := newAccountAdapter(acc)
+			if err != nil {
+				return fmt.Errorf("account %s: %w", acc.id, err)
+			}
+			adapters = append(adapters, a)
+		}
+		adapter = composite.NewCompositeAdapter(activeProfile, "All accounts", adapters...)
+	}
+
+	if viper.GetBool("offline") {
+		o, ok := adapter.(offlineSwitch)
+		switch {
+		case ok && viper.GetBool("cache.enabled"):
+			o.SetOffline(true)
+		case len(accounts) == 0 && currentAccount().provider == "ics":
+			// Local files are always available
+		default:
+			return fmt.Errorf("offline mode requires the event cache (set cache.enabled: true)")
+		}
+	}
+
+	if err := adapter.Login(cmd.Context()); err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+
+	// Accounts that couldn't log in are left out of a multi-account view
+	if s, ok := adapter.(interface{ Skipped() []error }); ok {
+		for _, err := range s.Skipped() {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// account holds the provider settings of one calendar account.
+type account struct {
+	id              string
+	provider        string
+	credentialsFile string
+	tokenFile       string
+	clientID        string
+	tenantID        string
+	url             string
+	urls            []string
+	username        string
+	password        string
+	bearerToken     string
+	email           string
+	path            string
+}
+
+// currentAccount returns the account configured by the active profile,
+// or by the top-level settings when no profile is active.
+func currentAccount() account {
 	provider := viper.GetString("provider")
 	if provider == "" {
 		provider = "google"
 	}
 
-	switch provider {
+	return account{
+		id:              providerID(provider),
+		provider:        provider,
+		credentialsFile: viper.GetString("credentials_file"),
+		tokenFile:       viper.GetString("token_file"),
+		clientID:        viper.GetString("client_id"),
+		tenantID:        viper.GetString("tenant_id"),
+		url:             viper.GetString("url"),
+		urls:            viper.GetStringSlice("urls"),
+		username:        viper.GetString("username"),
+		password:        viper.GetString("password"),
+		bearerToken:     viper.GetString("bearer_token"),
+		email:           viper.GetString("email"),
+		path:            viper.GetString("path"),
+	}
+}
+
+// profileAccounts returns the accounts listed under the active profile's
+// "accounts" key. Each entry names another profile that holds the
+// provider settings of that account.
+func profileAccounts() ([]account, error) {
+	if activeProfile == "" {
+		return nil, nil
+	}
+
+	names := viper.GetStringSlice("profiles." + activeProfile + ".accounts")
+
+	var accounts []account
+	for _, name := range names {
+		profileKey := "profiles." + name
+		if !viper.IsSet(profileKey) {
+			return nil, fmt.Errorf("account '%s' not found in profiles", name)
+		}
+		if viper.IsSet(profileKey + ".accounts") {
+			return nil, fmt.Errorf("account '%s' lists accounts itself; accounts must be single-provider profiles", name)
+		}
+
+		provider := profileString(profileKey, "provider")
+		if provider == "" {
+			provider = "google"
+		}
+
+		accounts = append(accounts, account{
+			id:              name,
+			provider:        provider,
+			credentialsFile: profileString(profileKey, "credentials_file"),
+			tokenFile:       profileString(profileKey, "token_file"),
+			clientID:        profileString(profileKey, "client_id"),
+			tenantID:        profileString(profileKey, "tenant_id"),
+			url:             profileString(profileKey, "url"),
+			urls:            profileStrings(profileKey, "urls"),
+			username:        profileString(profileKey, "username"),
+			password:        profileString(profileKey, "password"),
+			bearerToken:     profileString(profileKey, "bearer_token"),
+			email:           profileString(profileKey, "email"),
+			path:            profileString(profileKey, "path"),
+		})
+	}
+
+	return accounts, nil
+}
+
+// profileString reads a setting from a profile, falling back to the
+// top-level value.
+func profileString(profileKey, key string) string {
+	if viper.IsSet(profileKey + "." + key) {
+		return viper.GetString(profileKey + "." + key)
+	}
+	return viper.GetString(key)
+}
+
+// profileStrings reads a list setting from a profile, falling back to the
+// top-level value.
+func profileStrings(profileKey, key string) []string {
+	if viper.IsSet(profileKey + "." + key) {
+		return viper.GetStringSlice(profileKey + "." + key)
+	}
+	return viper.GetStringSlice(key)
+}
+
+// newAccountAdapter creates the adapter for one account, backed by the
+// event cache unless it is disabled.
+func newAccountAdapter(acc account) (core.CalendarAdapter, error) {
+	var a core.CalendarAdapter
+	var err error
+	switch acc.provider {
 	case "google":
-		return initGoogleAdapter(cmd)
+		a, err = initGoogleAdapter(acc)
 	case "outlook":
-		return initOutlookAdapter(cmd)
+		a, err = initOutlookAdapter(acc)
+	case "caldav":
+		a, err = initCalDAVAdapter(acc)
+	case "ics":
+		// Local files are read directly, caching them gains nothing
+		return initICSAdapter(acc)
+	case "ics_url":
+		// Feeds keep their own copies, revalidated with conditional requests
+		return initICSURLAdapter(acc)
 	default:
-		return fmt.Errorf("unknown provider: %s (supported: google, outlook)", provider)
+		return nil, fmt.Errorf("unknown provider: %s (supported: google, outlook, caldav, ics, ics_url)", acc.provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Serve reads from the local event cache unless disabled
+	if viper.GetBool("cache.enabled") {
+		store := storage.NewFileStorage(expandPath(viper.GetString("cache.dir")))
+		a = cached.NewCachedAdapter(a, store, viper.GetDuration("cache.refresh_interval"))
+	}
+
+	return a, nil
+}
+
+// providerID identifies the active account. The profile name keeps cached
+// data of different accounts on the same provider apart.
+func providerID(provider string) string {
+	if activeProfile != "" {
+		return activeProfile
+	}
+	return provider
+}
+
+// offlineSwitch is implemented by adapters that can serve cached data
+// without contacting the provider.
+type offlineSwitch interface {
+	SetOffline(offline bool)
+	Offline() bool
+	SyncedAt(start, end time.Time) (time.Time, bool)
+}
+
+// waitForCache lets background cache refreshes finish before tsk exits,
+// so the next run starts from fresh data.
+func waitForCache(cmd *cobra.Command, args []string) {
+	if w, ok := adapter.(interface{ Wait() }); ok {
+		w.Wait()
 	}
 }
 
-func initGoogleAdapter(cmd *cobra.Command) error {
-	credsFile := expandPath(viper.GetString("credentials_file"))
-	tokenFile := expandPath(viper.GetString("token_file"))
+// offlineNotice describes the age of the data shown for [start, end) when
+// events are served from the cache without reaching the provider.
+// It returns "" when online.
+func offlineNotice(start, end time.Time) string {
+	o, ok := adapter.(offlineSwitch)
+	if !ok || !o.Offline() {
+		return ""
+	}
+
+	syncedAt, ok := o.SyncedAt(start, end)
+	if !ok {
+		return "⚠️  Offline — no cached data"
+	}
+	return fmt.Sprintf("⚠️  Offline — showing data synced %s ago (%s)",
+		formatDurationCompact(time.Since(syncedAt)), syncedAt.Local().Format("Jan 2 3:04 PM"))
+}
+
+func initGoogleAdapter(acc account) (core.CalendarAdapter, error) {
+	credsFile := expandPath(acc.credentialsFile)
+	tokenFile := expandPath(acc.tokenFile)
 
 	// Check if files exist
 	if _, err := os.Stat(credsFile); os.IsNotExist(err) {
-		return fmt.Errorf("credentials file not found: %s\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/google_setup.md", credsFile)
+		return nil, fmt.Errorf("credentials file not found: %s\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/google_setup.md", credsFile)
 	}
 
 	if _, err := os.Stat(tokenFile); os.IsNotExist(err) {
-		return fmt.Errorf("token file not found: %s\n\nRun 'tsk auth' to authenticate", tokenFile)
+		return nil, fmt.Errorf("token file not found: %s\n\nRun 'tsk auth' to authenticate", tokenFile)
 	}
 
-	adapter = google.NewGoogleAdapter(
-		"google",
+	return google.NewGoogleAdapter(
+		acc.id,
 		"Google Calendar",
 		credsFile,
 		tokenFile,
-	)
-
-	if err := adapter.Login(cmd.Context()); err != nil {
-		return fmt.Errorf("login failed: %w", err)
-	}
-
-	return nil
+	), nil
 }
 
-func initOutlookAdapter(cmd *cobra.Command) error {
-	clientID := viper.GetString("client_id")
+func initOutlookAdapter(acc account) (core.CalendarAdapter, error) {
+	clientID := acc.clientID
 	if clientID == "" {
-		return fmt.Errorf("client_id not configured for Outlook provider\n\nAdd it to your profile config:\n  client_id: \"your-azure-app-client-id\"\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/outlook_setup.md")
+		return nil, fmt.Errorf("client_id not configured for Outlook provider\n\nAdd it to your profile config:\n  client_id: \"your-azure-app-client-id\"\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/outlook_setup.md")
 	}
 
-	tenantID := viper.GetString("tenant_id")
-	tokenFile := expandPath(viper.GetString("token_file"))
+	tenantID := acc.tenantID
+	tokenFile := expandPath(acc.tokenFile)
 
 	if _, err := os.Stat(tokenFile); os.IsNotExist(err) {
-		return fmt.Errorf("token file not found: %s\n\nRun 'tsk auth' to authenticate with Microsoft", tokenFile)
+		return nil, fmt.Errorf("token file not found: %s\n\nRun 'tsk auth' to authenticate with Microsoft", tokenFile)
 	}
 
-	adapter = outlook.NewOutlookAdapter(
-		"outlook",
+	return outlook.NewOutlookAdapter(
+		acc.id,
 		"Outlook Calendar",
 		clientID,
 		tenantID,
 		tokenFile,
-	)
+	), nil
+}
 
-	if err := adapter.Login(cmd.Context()); err != nil {
-		return fmt.Errorf("login failed: %w", err)
+func initCalDAVAdapter(acc account) (core.CalendarAdapter, error) {
+	if acc.url == "" {
+		return nil, fmt.Errorf("url not configured for CalDAV provider\n\nAdd it to your profile config:\n  url: \"https://caldav.example.com/\"\n  username: \"you@example.com\"\n  password: \"app-specific-password\"\n\nSetup guide: https://github.com/theakshaypant/tsk/tree/main/docs/caldav_setup.md")
+	}
+	if acc.password == "" && acc.bearerToken == "" {
+		return nil, fmt.Errorf("no credentials configured for CalDAV provider\n\nAdd username and password (or bearer_token) to your profile config")
 	}
 
-	return nil
+	return caldav.NewCalDAVAdapter(
+		acc.id,
+		"CalDAV",
+		acc.url,
+		acc.username,
+		acc.password,
+		acc.bearerToken,
+		acc.email,
+	), nil
+}
+
+func initICSAdapter(acc account) (core.CalendarAdapter, error) {
+	if acc.path == "" {
+		return nil, fmt.Errorf("path not configured for ics provider\n\nAdd it to your profile config:\n  path: ~/calendars/work.ics   # or a vdirsyncer directory")
+	}
+
+	path := expandPath(acc.path)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, fmt.Errorf("calendar path not found: %s", path)
+	}
+
+	return ics.NewICSAdapter(
+		acc.id,
+		"Local Calendar",
+		path,
+		acc.email,
+	), nil
+}
+
+func initICSURLAdapter(acc account) (core.CalendarAdapter, error) {
+	urls := acc.urls
+	if len(urls) == 0 && acc.url != "" {
+		urls = []string{acc.url}
+	}
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("urls not configured for ics_url provider\n\nAdd the feeds to your profile config:\n  urls:\n    - webcal://example.com/oncall.ics\n    - https://example.com/holidays.ics")
+	}
+
+	// Copies of the feeds live next to the event cache, unless it is disabled
+	cacheDir := ""
+	if viper.GetBool("cache.enabled") {
+		cacheDir = expandPath(viper.GetString("cache.dir"))
+	}
+
+	return icsurl.NewICSURLAdapter(
+		acc.id,
+		"Subscribed Calendars",
+		urls,
+		acc.email,
+		cacheDir,
+		viper.GetDuration("cache.refresh_interval"),
+	), nil
 }
 
 func listEvents(cmd *cobra.Command, args []string) error {
+	if err := checkOutputFormat(listOutput); err != nil {
+		return err
+	}
+	var tmpl *template.Template
+	if listFormat != "" {
+		if listOutput != "text" {
+			return fmt.Errorf("choose one of --format or --output")
+		}
+		var err error
+		if tmpl, err = parseEventTemplate(listFormat); err != nil {
+			return err
+		}
+	}
+
 	now := time.Now()
-	var start, end time.Time
+	events, start, end, err := fetchFilteredEvents(cmd, now)
+	if err != nil {
+		return err
+	}
+
+	if listOutput != "text" || tmpl != nil {
+		if notice := offlineNotice(start, end); notice != "" {
+			fmt.Fprintln(os.Stderr, notice)
+		}
+		if tmpl != nil {
+			return renderEvents(os.Stdout, tmpl, events)
+		}
+		return writeEvents(os.Stdout, listOutput, events)
+	}
+
+	if notice := offlineNotice(start, end); notice != "" {
+		fmt.Println(notice)
+	}
+	fmt.Printf("📅 Events from %s to %s:\n", now.Format("Jan 2"), end.Format("Jan 2"))
+	fmt.Println("─────────────────────────────────────────────────")
+
+	if len(events) == 0 {
+		fmt.Println("No upcoming events found.")
+		return nil
+	}
+
+	for _, event := range events {
+		printEvent(event)
+	}
+
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Printf("Total: %d events\n", len(events))
+
+	return nil
+}
+
+// fetchFilteredEvents fetches the events selected by the date range and
+// filter flags, as listed by 'tsk'.
+func fetchFilteredEvents(cmd *cobra.Command, now time.Time) (events []core.Event, start, end time.Time, err error) {
+	start, end, err = dateRange(now)
+	if err != nil {
+		return nil, start, end, err
+	}
+
+	opts, err := filterOptions(start, end)
+	if err != nil {
+		return nil, start, end, err
+	}
+
+	events, err = adapter.FetchEvents(cmd.Context(), opts)
+	if err != nil {
+		return nil, start, end, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	// Smart OOO filter: hide events on days you're OOO
+	if viper.GetBool("smart_ooo") {
+		primaryCal := detectPrimaryCalendar(viper.GetString("primary_calendar"))
+		oooPeriods := getOOOPeriods(cmd.Context(), now, end, primaryCal)
+		if len(oooPeriods) > 0 {
+			events = filterEventsOutsideOOO(events, oooPeriods, primaryCal)
+		}
+	}
 
-	// Determine date range
+	return events, start, end, nil
+}
+
+// dateRange returns the range given by --from/--to, or --days from now.
+func dateRange(now time.Time) (start, end time.Time, err error) {
 	fromStr := viper.GetString("from")
 	toStr := viper.GetString("to")
 
-	if fromStr != "" || toStr != "" {
-		// Use explicit date range
-		if fromStr != "" {
-			var err error
-			start, err = parseDate(fromStr, now)
-			if err != nil {
-				return err
-			}
-		} else {
-			start = now
-		}
+	if fromStr == "" && toStr == "" {
+		days := viper.GetInt("days")
+		return now, now.Add(time.Duration(days) * 24 * time.Hour), nil
+	}
 
-		if toStr != "" {
-			var err error
-			end, err = parseDate(toStr, now)
-			if err != nil {
-				return err
-			}
-			// End of day
-			end = end.Add(24*time.Hour - time.Second)
-		} else {
-			// Default to 7 days from start
-			days := viper.GetInt("days")
-			end = start.Add(time.Duration(days) * 24 * time.Hour)
+	start = now
+	if fromStr != "" {
+		if start, err = parseDate(fromStr, now); err != nil {
+			return start, end, err
 		}
+	}
+	if toStr != "" {
+		if end, err = parseDate(toStr, now); err != nil {
+			return start, end, err
+		}
+		// End of day
+		end = end.Add(24*time.Hour - time.Second)
 	} else {
-		// Use days from now
 		days := viper.GetInt("days")
-		start = now
-		end = now.Add(time.Duration(days) * 24 * time.Hour)
+		end = start.Add(time.Duration(days) * 24 * time.Hour)
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("--to must be after --from")
 	}
+	return start, end, nil
+}
 
+// filterOptions builds the fetch options for [start, end) from the
+// calendar, event type and status filter flags.
+func filterOptions(start, end time.Time) (core.FetchOptions, error) {
 	opts := core.FetchOptions{
 		Start: start,
 		End:   end,
@@ -328,7 +696,7 @@ func listEvents(cmd *cobra.Command, args []string) error {
 		filterNames := strings.Split(calendars, ",")
 		calendarIDs := resolveCalendarNames(filterNames, adapter.Calendars())
 		if len(calendarIDs) == 0 {
-			return fmt.Errorf("no matching calendars found for: %s\nUse 'tsk calendars' to see available calendars", calendars)
+			return opts, fmt.Errorf("no matching calendars found for: %s\nUse 'tsk calendars' to see available calendars", calendars)
 		}
 		opts.CalendarIDs = calendarIDs
 	}
@@ -367,36 +735,7 @@ func listEvents(cmd *cobra.Command, args []string) error {
 		opts.ExcludeAllDay = true
 	}
 
-	events, err := adapter.FetchEvents(cmd.Context(), opts)
-	if err != nil {
-		return fmt.Errorf("failed to fetch events: %w", err)
-	}
-
-	// Smart OOO filter: hide events on days you're OOO
-	if viper.GetBool("smart_ooo") {
-		primaryCal := detectPrimaryCalendar(viper.GetString("primary_calendar"))
-		oooPeriods := getOOOPeriods(cmd.Context(), now, end, primaryCal)
-		if len(oooPeriods) > 0 {
-			events = filterEventsOutsideOOO(events, oooPeriods, primaryCal)
-		}
-	}
-
-	fmt.Printf("📅 Events from %s to %s:\n", now.Format("Jan 2"), end.Format("Jan 2"))
-	fmt.Println("─────────────────────────────────────────────────")
-
-	if len(events) == 0 {
-		fmt.Println("No upcoming events found.")
-		return nil
-	}
-
-	for _, event := range events {
-		printEvent(event)
-	}
-
-	fmt.Println("─────────────────────────────────────────────────")
-	fmt.Printf("Total: %d events\n", len(events))
-
-	return nil
+	return opts, nil
 }
 
 // DisplayOptions controls how events are displayed
@@ -408,6 +747,7 @@ type DisplayOptions struct {
 	ShowMeetLink   bool   // Show meeting link
 	ShowDesc       bool   // Show description
 	ShowStatus     bool   // Show response status
+	ShowAttendees  bool   // Show organizer and attendees
 	ShowEventURL   bool   // Show calendar event URL
 	ShowAttach     bool   // Show attachments
 	ShowID         bool   // Show event ID
@@ -425,6 +765,7 @@ func DefaultDisplayOptions() DisplayOptions {
 		ShowMeetLink:   true,
 		ShowDesc:       true,
 		ShowStatus:     true,
+		ShowAttendees:  true,
 		ShowEventURL:   true,
 		ShowAttach:     false,
 		ShowID:         false,
@@ -443,6 +784,7 @@ func DetailedDisplayOptions() DisplayOptions {
 		ShowMeetLink:   true,
 		ShowDesc:       true,
 		ShowStatus:     true,
+		ShowAttendees:  true,
 		ShowEventURL:   true,
 		ShowAttach:     true,
 		ShowID:         true,
@@ -477,6 +819,9 @@ func DisplayOptionsFromConfig(detailed bool) DisplayOptions {
 	if viper.IsSet("display.status") {
 		opts.ShowStatus = viper.GetBool("display.status")
 	}
+	if viper.IsSet("display.attendees") {
+		opts.ShowAttendees = viper.GetBool("display.attendees")
+	}
 	if viper.IsSet("display.event_url") {
 		opts.ShowEventURL = viper.GetBool("display.event_url")
 	}
@@ -554,6 +899,20 @@ func DisplayEvent(event core.Event, opts DisplayOptions) {
 		}
 	}
 
+	if opts.ShowAttendees {
+		if !opts.Compact && event.Organizer != "" {
+			fmt.Printf("%s👤 Organizer:   %s\n", indent, formatPerson(event.OrganizerName, event.Organizer))
+		}
+		if summary := event.AttendeeSummary(); summary.Total() > 0 {
+			fmt.Printf("%s👥 Attendees:   %s\n", indent, formatAttendeeSummary(summary))
+			if !opts.Compact {
+				for _, attendee := range event.Attendees {
+					fmt.Printf("%s   %s\n", indent, formatAttendee(attendee))
+				}
+			}
+		}
+	}
+
 	if opts.ShowEventURL && event.URL != "" {
 		linkText := util.MakeHyperlink(event.URL, event.URL)
 		fmt.Printf("%s🔗 Event:       %s\n", indent, linkText)
@@ -676,6 +1035,64 @@ func formatStatus(status core.EventStatus) string {
 	}
 }
 
+// formatAttendeeSummary formats attendee responses, e.g.
+// "5 guests: 3 accepted, 2 pending".
+func formatAttendeeSummary(s core.AttendeeSummary) string {
+	var parts []string
+	for _, count := range []struct {
+		n     int
+		label string
+	}{
+		{s.Accepted, "accepted"},
+		{s.Declined, "declined"},
+		{s.Tentative, "tentative"},
+		{s.Pending, "pending"},
+	} {
+		if count.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count.n, count.label))
+		}
+	}
+
+	guests := "guests"
+	if s.Total() == 1 {
+		guests = "guest"
+	}
+	return fmt.Sprintf("%d %s: %s", s.Total(), guests, strings.Join(parts, ", "))
+}
+
+// formatAttendee formats one attendee with their response
+func formatAttendee(a core.Attendee) string {
+	mark := "…"
+	switch a.Response {
+	case core.StatusAccepted:
+		mark = "✓"
+	case core.StatusRejected:
+		mark = "✗"
+	case core.StatusTentative:
+		mark = "?"
+	}
+
+	text := mark + " " + formatPerson(a.Name, a.Email)
+	if a.IsResource {
+		text += " (resource)"
+	} else if a.Optional {
+		text += " (optional)"
+	}
+	return text
+}
+
+// formatPerson shows a name with its address, or whichever is known
+func formatPerson(name, email string) string {
+	switch {
+	case name == "" || strings.EqualFold(name, email):
+		return email
+	case email == "":
+		return name
+	default:
+		return fmt.Sprintf("%s <%s>", name, email)
+	}
+}
+
 func formatEventType(t core.EventType) string {
 	switch t {
 	case core.TypeOutOfOffice:
@@ -697,7 +1114,8 @@ func truncate(s string, maxLen int) string {
 }
 
 // parseDate parses a date string in various formats
-// Supports: YYYY-MM-DD, "today", "tomorrow", "yesterday", weekday names
+// Supports: YYYY-MM-DD, "today", "tomorrow", "yesterday", weekday names,
+// "next week" (its Monday)
 func parseDate(s string, defaultTime time.Time) (time.Time, error) {
 	s = strings.ToLower(strings.TrimSpace(s))
 	now := time.Now()
@@ -710,6 +1128,13 @@ func parseDate(s string, defaultTime time.Time) (time.Time, error) {
 		return today.AddDate(0, 0, 1), nil
 	case "yesterday":
 		return today.AddDate(0, 0, -1), nil
+	case "next week":
+		// Monday of next week
+		daysUntil := int(time.Monday - today.Weekday())
+		if daysUntil <= 0 {
+			daysUntil += 7
+		}
+		return today.AddDate(0, 0, daysUntil), nil
 	}
 
 	// Check for weekday names (e.g., "monday", "next tuesday")
@@ -755,7 +1180,7 @@ func parseDate(s string, defaultTime time.Time) (time.Time, error) {
 		return t, nil
 	}
 
-	return defaultTime, fmt.Errorf("unable to parse date: %s (use YYYY-MM-DD, 'today', 'tomorrow', or weekday names)", s)
+	return defaultTime, fmt.Errorf("unable to parse date: %s (use YYYY-MM-DD, 'today', 'tomorrow', 'next week', or weekday names)", s)
 }
 
 // expandPath expands ~ to the user's home directory
@@ -820,6 +1245,11 @@ func detectPrimaryCalendar(configured string) string {
 	if _, exists := calendars["primary"]; exists {
 		return "primary"
 	}
+	for id := range calendars {
+		if strings.HasSuffix(id, "/primary") {
+			return id // primary calendar of an account in a multi-account profile
+		}
+	}
 
 	// Look for a calendar named "Calendar" (Outlook default)
 	for id, name := range calendars {
@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	scheduleWith     []string
+	scheduleDuration time.Duration
+	scheduleHours    string
+	scheduleLimit    int
+	scheduleOutput   string
+	scheduleBook     bool
+	scheduleTitle    string
+	scheduleCalendar string
+	scheduleMeet     bool
+	scheduleTeams    bool
+	scheduleYes      bool
+)
+
+// scheduleStep is how far apart candidate start times are.
+const scheduleStep = 30 * time.Minute
+
+// schedulePerDay caps the suggestions from a single day, so the list
+// offers a few different days rather than every half hour of the first.
+const schedulePerDay = 2
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule --with <email>[,<email>...]",
+	Short: "Find a time that works for several people",
+	Long: `Look up when the people given with --with are busy, combine that with
+your own calendar, and suggest the best times to meet within working hours.
+
+Suggestions are ranked: sooner is better, a slot with some room before and
+after beats one squeezed between meetings, and on the hour beats half past.
+At most two suggestions come from the same day when there are other days
+to choose from.
+
+Other people's calendars are read through free/busy lookups, so you only
+see when they're busy, not what they're doing. People whose free/busy
+can't be looked up (outside your organization, or not shared) are listed
+and left out.
+
+The range and working hours are the same as for 'tsk free'.
+
+With --book, pick one of the suggestions and tsk creates the meeting and
+invites everyone. With --book --yes, the top suggestion is booked.
+
+Examples:
+  # An hour with two colleagues this week
+  tsk schedule --with alex@example.com,sam@example.com --duration 1h
+
+  # Next week only, more suggestions
+  tsk schedule --with alex@example.com --from monday --to friday --limit 10
+
+  # Pick a slot and send the invite, with a Meet link
+  tsk schedule --with alex@example.com --duration 45m --book --title "Roadmap" --meet
+
+Supported providers: Google Calendar and Outlook.`,
+	RunE: runSchedule,
+}
+
+func init() {
+	rootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.Flags().StringSliceVarP(&scheduleWith, "with", "w", nil, "Email addresses of the people to meet (repeatable or comma-separated)")
+	scheduleCmd.Flags().DurationVar(&scheduleDuration, "duration", 30*time.Minute, "Length of the meeting")
+	scheduleCmd.Flags().StringVar(&scheduleHours, "hours", "", "Working hours, e.g. 09:00-17:00 (default from config)")
+	scheduleCmd.Flags().IntVar(&scheduleLimit, "limit", 5, "Number of suggestions to show")
+	scheduleCmd.Flags().StringVarP(&scheduleOutput, "output", "o", "text", "Output format: text or json")
+	scheduleCmd.Flags().BoolVar(&scheduleBook, "book", false, "Pick a suggestion and create the meeting")
+	scheduleCmd.Flags().StringVar(&scheduleTitle, "title", "Meeting", "Title of the meeting created with --book")
+	scheduleCmd.Flags().StringVar(&scheduleCalendar, "calendar", "", "Calendar to create the meeting in (default: primary calendar)")
+	scheduleCmd.Flags().BoolVar(&scheduleMeet, "meet", false, "Add a Google Meet link (Google Calendar)")
+	scheduleCmd.Flags().BoolVar(&scheduleTeams, "teams", false, "Add a Microsoft Teams meeting (Outlook)")
+	scheduleCmd.Flags().BoolVarP(&scheduleYes, "yes", "y", false, "With --book, book the top suggestion without asking")
+}
+
+func runSchedule(cmd *cobra.Command, args []string) error {
+	emails := cleanAttendees(scheduleWith)
+	if len(emails) == 0 {
+		return fmt.Errorf("--with needs at least one email address")
+	}
+	if scheduleDuration <= 0 {
+		return fmt.Errorf("--duration must be positive")
+	}
+	if scheduleLimit <= 0 {
+		return fmt.Errorf("--limit must be positive")
+	}
+	if scheduleOutput != "text" && scheduleOutput != "json" {
+		return fmt.Errorf("invalid --output %q (use text or json)", scheduleOutput)
+	}
+	if scheduleBook && scheduleOutput == "json" {
+		return fmt.Errorf("--book can't be combined with --output json")
+	}
+	if scheduleMeet && scheduleTeams {
+		return fmt.Errorf("choose one of --meet or --teams")
+	}
+
+	checker, ok := adapter.(core.FreeBusyChecker)
+	if !ok {
+		return formatScheduleError(core.ErrNotImplemented)
+	}
+
+	// Check the calendar to book in before looking anything up
+	var calendarID string
+	if scheduleBook {
+		var err error
+		if calendarID, err = targetCalendar(scheduleCalendar); err != nil {
+			return err
+		}
+		if !canCreate(calendarID) {
+			return formatCreateError(core.ErrNotImplemented)
+		}
+	}
+
+	hours, err := loadWorkingHours(scheduleHours)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	start, end, err := dateRange(now)
+	if err != nil {
+		return err
+	}
+	if start.Before(now) {
+		start = now
+	}
+
+	others, err := checker.FreeBusy(cmd.Context(), emails, start, end)
+	if err != nil {
+		return formatScheduleError(err)
+	}
+	var unknown []string
+	for _, email := range emails {
+		if _, ok := others[email]; !ok {
+			unknown = append(unknown, email)
+		}
+	}
+	if len(unknown) == len(emails) {
+		return fmt.Errorf("could not look up free/busy for %s\n\nTheir calendars may not be shared with you", strings.Join(unknown, ", "))
+	}
+	if len(unknown) > 0 {
+		fmt.Fprintf(os.Stderr, "⚠️  No free/busy information for %s; not taken into account\n", strings.Join(unknown, ", "))
+	}
+
+	busy, err := fetchBusyEvents(cmd, start, end)
+	if err != nil {
+		return err
+	}
+	for _, periods := range others {
+		for _, p := range periods {
+			busy = append(busy, core.Event{Start: p.Start, End: p.End})
+		}
+	}
+	ooo := getOOOPeriods(cmd.Context(), start, end, detectPrimaryCalendar(viper.GetString("primary_calendar")))
+
+	gaps := findFreeSlots(busy, ooo, hours, start, end, scheduleDuration)
+	slots := rankMeetingSlots(gaps, scheduleDuration, now, scheduleLimit)
+
+	if scheduleOutput == "json" {
+		return printFreeSlotsJSON(slots)
+	}
+
+	fmt.Printf("🤝 %s with %s, %s to %s:\n", formatDurationCompact(scheduleDuration), strings.Join(emails, ", "), start.Format("Jan 2"), end.Format("Jan 2"))
+	fmt.Println("─────────────────────────────────────────────────")
+
+	if len(slots) == 0 {
+		fmt.Println("No time found that works for everyone.")
+		return nil
+	}
+	for i, slot := range slots {
+		fmt.Printf("  %d. %s  %s – %s\n", i+1, slot.Start.Format("Mon Jan 2"), slot.Start.Format("15:04"), slot.End.Format("15:04"))
+	}
+	fmt.Println("─────────────────────────────────────────────────")
+
+	if !scheduleBook {
+		return nil
+	}
+
+	slot := slots[0]
+	if !scheduleYes {
+		n, ok := pickSlot(len(slots))
+		if !ok {
+			return fmt.Errorf("cancelled, nothing was created")
+		}
+		slot = slots[n-1]
+	}
+	fmt.Println()
+	return bookSlot(cmd, calendarID, slot, emails)
+}
+
+// rankMeetingSlots turns free gaps into meeting suggestions of the given
+// length, best first. Candidates start on the half hour; lower scores win:
+//
+//   - 10 for every day from today
+//   - 3 for each side that has no room before the next meeting (or the
+//     edge of the working day)
+//   - 1 for starting at half past rather than on the hour
+//
+// Ties go to the earlier slot. At most schedulePerDay suggestions come from
+// one day, unless there aren't enough other days to fill the list.
+func rankMeetingSlots(gaps []FreeSlot, length time.Duration, now time.Time, limit int) []FreeSlot {
+	type candidate struct {
+		slot  FreeSlot
+		score int
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	var candidates []candidate
+	for _, gap := range gaps {
+		gapStart := gap.Start.Local()
+		midnight := time.Date(gapStart.Year(), gapStart.Month(), gapStart.Day(), 0, 0, 0, 0, time.Local)
+		days := int(midnight.Sub(today).Hours()/24 + 0.5)
+
+		// First step boundary at or after the gap's start
+		offset := gapStart.Sub(midnight)
+		first := midnight.Add((offset + scheduleStep - 1) / scheduleStep * scheduleStep)
+
+		for s := first; !s.Add(length).After(gap.End); s = s.Add(scheduleStep) {
+			e := s.Add(length)
+			score := days * 10
+			if s.Equal(gap.Start) {
+				score += 3
+			}
+			if e.Equal(gap.End) {
+				score += 3
+			}
+			if s.Minute() != 0 {
+				score++
+			}
+			candidates = append(candidates, candidate{FreeSlot{Start: s, End: e}, score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score < candidates[j].score
+		}
+		return candidates[i].slot.Start.Before(candidates[j].slot.Start)
+	})
+
+	var slots, skipped []FreeSlot
+	perDay := make(map[string]int)
+	for _, c := range candidates {
+		if len(slots) == limit {
+			break
+		}
+		day := c.slot.Start.Format("2006-01-02")
+		if perDay[day] == schedulePerDay {
+			skipped = append(skipped, c.slot)
+			continue
+		}
+		perDay[day]++
+		slots = append(slots, c.slot)
+	}
+
+	// Too few days with room: fill up with the best of the rest
+	for _, slot := range skipped {
+		if len(slots) == limit {
+			break
+		}
+		slots = append(slots, slot)
+	}
+	return slots
+}
+
+// pickSlot asks which of n suggestions to book; Enter cancels.
+func pickSlot(n int) (int, bool) {
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Printf("Book which slot? [1-%d, Enter to cancel] ", n)
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			if err != nil {
+				fmt.Println()
+			}
+			return 0, false
+		}
+		if choice, convErr := strconv.Atoi(answer); convErr == nil && choice >= 1 && choice <= n {
+			return choice, true
+		}
+		if err != nil {
+			fmt.Println()
+			return 0, false
+		}
+		fmt.Printf("Please enter a number from 1 to %d.\n", n)
+	}
+}
+
+// bookSlot creates the meeting in slot and invites emails.
+func bookSlot(cmd *cobra.Command, calendarID string, slot FreeSlot, emails []string) error {
+	creator, ok := adapter.(core.EventCreator)
+	if !ok {
+		return formatCreateError(core.ErrNotImplemented)
+	}
+
+	draft := core.EventDraft{
+		Title:     scheduleTitle,
+		Start:     slot.Start,
+		End:       slot.End,
+		Attendees: emails,
+	}
+	switch {
+	case scheduleMeet:
+		draft.Conference = core.ConferenceGoogleMeet
+	case scheduleTeams:
+		draft.Conference = core.ConferenceTeams
+	}
+
+	event, err := creator.CreateEvent(cmd.Context(), calendarID, draft)
+	if err != nil {
+		return formatCreateError(err)
+	}
+
+	printCreatedEvent(event, draft.Attendees)
+	return nil
+}
+
+// formatScheduleError converts core errors to user-friendly messages.
+func formatScheduleError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return fmt.Errorf("insufficient permissions to look up free/busy\n\nPlease re-authenticate with updated permissions:\n  tsk auth")
+	case errors.Is(err, core.ErrNotImplemented):
+		return fmt.Errorf("free/busy lookups are not supported for this provider\n\nCurrently supported:\n  ✅ Google Calendar\n  ✅ Outlook")
+	case errors.Is(err, core.ErrOffline):
+		return fmt.Errorf("cannot look up other people's calendars while offline\n\nRun the command again without --offline once you are connected")
+	default:
+		return fmt.Errorf("failed to look up free/busy: %w", err)
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRankMeetingSlots(t *testing.T) {
+	// Berlin switches to summer time on Sunday 2026-03-29
+	useLocal(t, "Europe/Berlin")
+
+	gap := func(start, end string) FreeSlot {
+		return FreeSlot{Start: local(start), End: local(end)}
+	}
+
+	tests := []struct {
+		name   string
+		gaps   []FreeSlot
+		length time.Duration
+		now    string
+		limit  int
+		// want lists the suggested starts as "Mon 15:04"
+		want []string
+	}{
+		{
+			name:   "starts on the half hour",
+			gaps:   []FreeSlot{gap("2026-03-02 09:10", "2026-03-02 10:30")},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 5,
+			want: []string{"Mon 09:30", "Mon 10:00"},
+		},
+		{
+			name:   "room on both sides first",
+			gaps:   []FreeSlot{gap("2026-03-02 09:00", "2026-03-02 11:00")},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 2,
+			want: []string{"Mon 10:00", "Mon 09:30"},
+		},
+		{
+			name:   "slot filling the gap",
+			gaps:   []FreeSlot{gap("2026-03-02 09:10", "2026-03-02 10:30")},
+			length: time.Hour, now: "2026-03-02 08:00", limit: 5,
+			want: []string{"Mon 09:30"},
+		},
+		{
+			name:   "gap too short",
+			gaps:   []FreeSlot{gap("2026-03-02 09:00", "2026-03-02 09:20")},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 5,
+		},
+		{
+			name: "later days cost more",
+			gaps: []FreeSlot{
+				gap("2026-03-02 09:00", "2026-03-02 09:30"),
+				gap("2026-03-03 10:00", "2026-03-03 12:00"),
+			},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 3,
+			want: []string{"Mon 09:00", "Tue 11:00", "Tue 10:30"},
+		},
+		{
+			name: "capped per day",
+			gaps: []FreeSlot{
+				gap("2026-03-02 09:00", "2026-03-02 12:00"),
+				gap("2026-03-03 09:00", "2026-03-03 12:00"),
+			},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 3,
+			want: []string{"Mon 10:00", "Mon 11:00", "Tue 10:00"},
+		},
+		{
+			name:   "backfilled from capped days",
+			gaps:   []FreeSlot{gap("2026-03-02 09:00", "2026-03-02 12:00")},
+			length: 30 * time.Minute, now: "2026-03-02 08:00", limit: 4,
+			want: []string{"Mon 10:00", "Mon 11:00", "Mon 09:30", "Mon 10:30"},
+		},
+		{
+			name: "days counted across DST",
+			gaps: []FreeSlot{
+				gap("2026-03-29 09:00", "2026-03-29 09:30"),
+				gap("2026-03-30 09:00", "2026-03-30 11:00"),
+			},
+			length: 30 * time.Minute, now: "2026-03-27 08:00", limit: 2,
+			want: []string{"Sun 09:00", "Mon 10:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slots := rankMeetingSlots(tt.gaps, tt.length, local(tt.now), tt.limit)
+
+			var got []string
+			for _, slot := range slots {
+				if slot.End.Sub(slot.Start) != tt.length {
+					t.Errorf("slot %v-%v is not %v long", slot.Start, slot.End, tt.length)
+				}
+				got = append(got, slot.Start.Format("Mon 15:04"))
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+var (
+	searchOutput string
+	searchFormat string
+	searchMonths int
+	searchLocal  bool
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search events by title, description, location or attendees",
+	Long: `Search the events of the past and coming months for text in their title,
+description, location or attendees. Every word of the query has to match.
+
+Google Calendar searches on the server, so the whole window isn't
+downloaded. Other providers, and any account while offline, are searched
+in the local cache. --local searches the fetched events for every provider.
+
+The window is six months either side of today (--months), or --from/--to
+when given. The calendar, event type and status filter flags work as for
+'tsk'.
+
+Examples:
+  # Find the offsite, wherever it's hiding
+  tsk search offsite
+
+  # Everything with Priya in the past and coming year
+  tsk search priya --months 12 --accepted=false
+
+  # As JSON, for scripts
+  tsk search "design review" -o json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+	searchCmd.Flags().StringVarP(&searchOutput, "output", "o", "text", "Output format: text, json, jsonl, csv, tsv or yaml")
+	searchCmd.Flags().StringVar(&searchFormat, "format", "", "Go template for each event, or the name of a template from the config")
+	searchCmd.Flags().IntVar(&searchMonths, "months", 6, "Months to search before and after today (ignored if --from/--to specified)")
+	searchCmd.Flags().BoolVar(&searchLocal, "local", false, "Search the fetched events instead of on the server")
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	if err := checkOutputFormat(searchOutput); err != nil {
+		return err
+	}
+	var tmpl *template.Template
+	if searchFormat != "" {
+		if searchOutput != "text" {
+			return fmt.Errorf("choose one of --format or --output")
+		}
+		var err error
+		if tmpl, err = parseEventTemplate(searchFormat); err != nil {
+			return err
+		}
+	}
+	if searchMonths < 1 {
+		return fmt.Errorf("--months must be at least 1")
+	}
+
+	query := strings.Join(args, " ")
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("nothing to search for")
+	}
+
+	now := time.Now()
+	start, end := now.AddDate(0, -searchMonths, 0), now.AddDate(0, searchMonths, 0)
+	if cmd.Flags().Changed("from") || cmd.Flags().Changed("to") {
+		var err error
+		if start, end, err = dateRange(now); err != nil {
+			return err
+		}
+	}
+
+	opts, err := filterOptions(start, end)
+	if err != nil {
+		return err
+	}
+	events, err := searchEvents(cmd.Context(), query, opts)
+	if err != nil {
+		return fmt.Errorf("failed to search events: %w", err)
+	}
+
+	if searchOutput != "text" || tmpl != nil {
+		if notice := offlineNotice(start, end); notice != "" {
+			fmt.Fprintln(os.Stderr, notice)
+		}
+		if tmpl != nil {
+			return renderEvents(os.Stdout, tmpl, events)
+		}
+		return writeEvents(os.Stdout, searchOutput, events)
+	}
+
+	if notice := offlineNotice(start, end); notice != "" {
+		fmt.Println(notice)
+	}
+	fmt.Printf("🔍 Events matching %q from %s to %s:\n", query, start.Format("Jan 2, 2006"), end.Format("Jan 2, 2006"))
+	fmt.Println("─────────────────────────────────────────────────")
+
+	if len(events) == 0 {
+		fmt.Println("No matching events found.")
+		return nil
+	}
+
+	for _, event := range events {
+		printEvent(event)
+	}
+
+	fmt.Println("─────────────────────────────────────────────────")
+	fmt.Printf("Total: %d events\n", len(events))
+
+	return nil
+}
+
+// searchEvents searches on the server when the provider can, and in the
+// fetched events otherwise or with --local.
+func searchEvents(ctx context.Context, query string, opts core.FetchOptions) ([]core.Event, error) {
+	if searcher, ok := adapter.(core.EventSearcher); ok && !searchLocal {
+		events, err := searcher.SearchEvents(ctx, query, opts)
+		if !errors.Is(err, core.ErrNotImplemented) {
+			return events, err
+		}
+	}
+
+	events, err := adapter.FetchEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return eventutil.Matching(events, query), nil
+}
@@ -6,6 +6,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -17,19 +18,24 @@ import (
 var tuiCmd = &cobra.Command{
 	Use:   "ui",
 	Short: "Launch the interactive TUI",
-	Long:  `Launch an interactive terminal user interface for browsing calendar events.`,
-	RunE:  runTUI,
+	Long: `Launch an interactive terminal user interface for browsing calendar events.
+
+With --from or --to, it starts in the agenda: the days of the range as one
+list, with more loaded as you scroll past the end.`,
+	RunE: runTUI,
 }
 
 func init() {
 	tuiCmd.Flags().String("split", "side", "Panel split direction: side (side-by-side) or stack (top/bottom)")
 	tuiCmd.Flags().Int("list-percent", 0, "List panel size as percentage (10-90, 0 = auto)")
+	tuiCmd.Flags().String("view", "day", "View to start in: day, week, month or agenda")
 	viper.BindPFlag("ui.split", tuiCmd.Flags().Lookup("split"))
 	viper.BindPFlag("ui.list_percent", tuiCmd.Flags().Lookup("list-percent"))
+	viper.BindPFlag("ui.view", tuiCmd.Flags().Lookup("view"))
 	rootCmd.AddCommand(tuiCmd)
 }
 
-func parseUIOptions() tui.UIOptions {
+func parseUIOptions() (tui.UIOptions, error) {
 	split := strings.ToLower(viper.GetString("ui.split"))
 	var dir tui.SplitDirection
 	switch split {
@@ -39,10 +45,37 @@ func parseUIOptions() tui.UIOptions {
 		dir = tui.SplitSide
 	}
 
+	var view tui.ViewMode
+	switch strings.ToLower(viper.GetString("ui.view")) {
+	case "", "day":
+		view = tui.ViewDay
+	case "week":
+		view = tui.ViewWeek
+	case "month":
+		view = tui.ViewMonth
+	case "agenda":
+		view = tui.ViewAgenda
+	default:
+		return tui.UIOptions{}, fmt.Errorf("invalid view %q (use day, week, month or agenda)", viper.GetString("ui.view"))
+	}
+
+	// The agenda covers the range 'tsk' would list; asking for a range
+	// opens it
+	start, end, err := dateRange(time.Now())
+	if err != nil {
+		return tui.UIOptions{}, err
+	}
+	if viper.GetString("from") != "" || viper.GetString("to") != "" {
+		view = tui.ViewAgenda
+	}
+
 	return tui.UIOptions{
 		Split:       dir,
 		ListPercent: viper.GetInt("ui.list_percent"),
-	}
+		View:        view,
+		AgendaStart: start,
+		AgendaEnd:   end,
+	}, nil
 }
 
 func runTUI(cmd *cobra.Command, args []string) error {
@@ -50,7 +83,10 @@ func runTUI(cmd *cobra.Command, args []string) error {
 	opts := buildFetchOptions()
 
 	// Create the TUI model
-	uiOpts := parseUIOptions()
+	uiOpts, err := parseUIOptions()
+	if err != nil {
+		return err
+	}
 	m := tui.NewModel(adapter, opts, uiOpts)
 
 	// Set up the program with mouse support and alt screen
@@ -0,0 +1,743 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/storage"
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// DefaultRefreshInterval is how old cached data may get before a read
+// triggers a background refresh.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// backgroundTimeout bounds how long a background refresh may take.
+const backgroundTimeout = 2 * time.Minute
+
+// Providers that report changes (core.ChangeSyncer) sync a fixed window
+// around today once and afterwards only download what changed in it.
+const (
+	deltaPastDays   = 14
+	deltaFutureDays = 90
+)
+
+// ErrNoCache is returned in offline mode when nothing was ever synced.
+var ErrNoCache = errors.New("no cached data available - run tsk online at least once")
+
+// CachedAdapter wraps another adapter and serves events from local storage.
+// Reads hit the cache first; time ranges that were never synced are fetched
+// live, and stale ranges are refreshed in the background.
+//
+// In offline mode the wrapped adapter is never contacted and everything is
+// served from the cache. When the provider can't be reached, the cache is
+// served the same way until the next sync or change gets through.
+type CachedAdapter struct {
+	upstream        core.CalendarAdapter
+	store           *storage.FileStorage
+	refreshInterval time.Duration
+
+	syncMu  sync.Mutex // serializes syncs so tokens aren't used twice
+	loginMu sync.Mutex // serializes logins to the wrapped adapter
+
+	mu          sync.Mutex
+	offline     bool // requested by the user
+	unreachable bool // the last call to the provider failed to connect
+	loggedIn    bool
+	inflight    map[string]bool
+	wg          sync.WaitGroup
+	updates     chan struct{}
+}
+
+func NewCachedAdapter(upstream core.CalendarAdapter, store *storage.FileStorage, refreshInterval time.Duration) *CachedAdapter {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &CachedAdapter{
+		upstream:        upstream,
+		store:           store,
+		refreshInterval: refreshInterval,
+		inflight:        make(map[string]bool),
+		updates:         make(chan struct{}, 1),
+	}
+}
+
+func (c *CachedAdapter) ID() string   { return c.upstream.ID() }
+func (c *CachedAdapter) Name() string { return c.upstream.Name() }
+
+// SetOffline switches offline mode on or off.
+func (c *CachedAdapter) SetOffline(offline bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offline = offline
+}
+
+// Offline reports whether events are being served from the cache only,
+// either because offline mode was requested or the provider was unreachable.
+func (c *CachedAdapter) Offline() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.offline || c.unreachable
+}
+
+// offlineRequested reports whether offline mode was requested, as opposed
+// to the provider being unreachable right now.
+func (c *CachedAdapter) offlineRequested() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.offline
+}
+
+// track records whether the provider could be reached, judging by the
+// result of the last call to it, and returns err unchanged.
+func (c *CachedAdapter) track(err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unreachable = util.IsNetworkError(err)
+	return err
+}
+
+// SyncedAt returns when the cached events in [start, end) were last synced.
+// If the range was never fully synced, the provider's last sync time is
+// returned instead. ok is false if the provider was never synced.
+func (c *CachedAdapter) SyncedAt(start, end time.Time) (syncedAt time.Time, ok bool) {
+	state, err := c.store.LoadState(c.ID())
+	if err != nil || state.LastSync.IsZero() {
+		return time.Time{}, false
+	}
+	if covered, at := state.Coverage(start, end); covered && !at.IsZero() {
+		return at, true
+	}
+	return state.LastSync, true
+}
+
+// Login logs in to the wrapped adapter and remembers its calendar list.
+// Offline, or when the provider can't be reached, the cached calendar list
+// is used instead and the login is retried on the next call that needs
+// the provider.
+func (c *CachedAdapter) Login(ctx context.Context) error {
+	if c.offlineRequested() {
+		return c.checkCache()
+	}
+
+	if err := c.connect(ctx); err != nil {
+		if util.IsNetworkError(err) && c.checkCache() == nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// connect logs in to the wrapped adapter unless that already succeeded,
+// and records whether the provider could be reached.
+func (c *CachedAdapter) connect(ctx context.Context) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	c.mu.Lock()
+	loggedIn := c.loggedIn
+	c.mu.Unlock()
+	if loggedIn {
+		return nil
+	}
+
+	if err := c.track(c.upstream.Login(ctx)); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.loggedIn = true
+	c.mu.Unlock()
+
+	state, err := c.store.LoadState(c.ID())
+	if err != nil {
+		return nil // a broken cache must never block a working login
+	}
+	state.Calendars = c.upstream.Calendars()
+	_ = c.store.SaveState(c.ID(), state)
+
+	return nil
+}
+
+// Calendars returns the wrapped adapter's calendars (ID -> Name).
+// Until the login went through, the calendar list from the last sync is
+// returned.
+func (c *CachedAdapter) Calendars() map[string]string {
+	c.mu.Lock()
+	loggedIn := c.loggedIn
+	c.mu.Unlock()
+
+	if !loggedIn {
+		state, err := c.store.LoadState(c.ID())
+		if err != nil {
+			return nil
+		}
+		return state.Calendars
+	}
+	return c.upstream.Calendars()
+}
+
+// FetchEvents returns events from the cache, syncing the requested range
+// first if it was never fetched. Stale ranges are served as-is and
+// refreshed in the background.
+func (c *CachedAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	if c.offlineRequested() {
+		return c.cachedEvents(ctx, opts)
+	}
+
+	state, err := c.store.LoadState(c.ID())
+	if err != nil {
+		// Unreadable cache — fall back to the live provider
+		if err := c.connect(ctx); err != nil {
+			return nil, err
+		}
+		events, err := c.upstream.FetchEvents(ctx, opts)
+		return events, c.track(err)
+	}
+
+	covered, syncedAt := state.Coverage(opts.Start, opts.End)
+	if !covered {
+		if err := c.sync(ctx, opts.Start, opts.End); err != nil {
+			if !util.IsNetworkError(err) || state.LastSync.IsZero() {
+				return nil, err
+			}
+			// Provider unreachable — serve whatever is cached
+		}
+	} else if time.Since(syncedAt) > c.refreshInterval {
+		c.refreshInBackground(opts.Start, opts.End)
+	}
+
+	return c.cachedEvents(ctx, opts)
+}
+
+// Refresh syncs the requested range from the provider right away and
+// returns the updated events.
+func (c *CachedAdapter) Refresh(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	if c.offlineRequested() {
+		return nil, core.ErrOffline
+	}
+	if err := c.sync(ctx, opts.Start, opts.End); err != nil {
+		return nil, err
+	}
+	return c.cachedEvents(ctx, opts)
+}
+
+// RespondToEvent forwards the response to the wrapped adapter and updates
+// the cached copy so the new status shows up immediately.
+func (c *CachedAdapter) RespondToEvent(ctx context.Context, calendarID, eventID string, opts core.RespondOptions) error {
+	if err := c.online(ctx); err != nil {
+		return err
+	}
+	if err := c.track(c.upstream.RespondToEvent(ctx, calendarID, eventID, opts)); err != nil {
+		return err
+	}
+
+	events, err := c.store.ListEvents(ctx, core.EventFilter{
+		ProviderIDs: []string{c.ID()},
+		CalendarIDs: []string{calendarID},
+	})
+	if err != nil {
+		return nil
+	}
+
+	var updated []core.Event
+	for _, e := range events {
+		if e.ID == eventID {
+			e.Status = responseStatus(opts.Response)
+			updated = append(updated, e)
+		}
+	}
+	if len(updated) > 0 {
+		_ = c.store.SyncEvents(ctx, updated)
+	}
+
+	// Other instances (recurring scope) and the organizer's view may have
+	// changed too — make sure the next read refreshes.
+	c.invalidate()
+
+	return nil
+}
+
+// CanCreate reports whether the wrapped adapter can create events.
+// CachedAdapter itself always implements core.EventCreator.
+func (c *CachedAdapter) CanCreate(calendarID string) bool {
+	_, ok := c.upstream.(core.EventCreator)
+	return ok
+}
+
+// CreateEvent creates the event through the wrapped adapter and adds it to
+// the cache so it shows up immediately.
+func (c *CachedAdapter) CreateEvent(ctx context.Context, calendarID string, draft core.EventDraft) (core.Event, error) {
+	creator, ok := c.upstream.(core.EventCreator)
+	if !ok {
+		return core.Event{}, core.ErrNotImplemented
+	}
+	if err := c.online(ctx); err != nil {
+		return core.Event{}, err
+	}
+
+	event, err := creator.CreateEvent(ctx, calendarID, draft)
+	if err := c.track(err); err != nil {
+		return core.Event{}, err
+	}
+
+	_ = c.store.SyncEvents(ctx, []core.Event{event})
+	return event, nil
+}
+
+// UpdateEvent changes the event through the wrapped adapter and updates
+// the cached copy so the change shows up immediately.
+func (c *CachedAdapter) UpdateEvent(ctx context.Context, calendarID, eventID string, update core.EventUpdate) (core.Event, error) {
+	updater, ok := c.upstream.(core.EventUpdater)
+	if !ok {
+		return core.Event{}, core.ErrNotImplemented
+	}
+	if err := c.online(ctx); err != nil {
+		return core.Event{}, err
+	}
+
+	event, err := updater.UpdateEvent(ctx, calendarID, eventID, update)
+	if err := c.track(err); err != nil {
+		return core.Event{}, err
+	}
+
+	// A series update returns the master, which is never cached itself
+	if event.ID == eventID {
+		_ = c.store.SyncEvents(ctx, []core.Event{event})
+	}
+	c.invalidate()
+	return event, nil
+}
+
+// DeleteEvent deletes the event through the wrapped adapter and drops it
+// from the cache so it disappears immediately.
+func (c *CachedAdapter) DeleteEvent(ctx context.Context, calendarID, eventID string, opts core.DeleteOptions) error {
+	deleter, ok := c.upstream.(core.EventDeleter)
+	if !ok {
+		return core.ErrNotImplemented
+	}
+	if err := c.online(ctx); err != nil {
+		return err
+	}
+
+	if err := c.track(deleter.DeleteEvent(ctx, calendarID, eventID, opts)); err != nil {
+		return err
+	}
+
+	_ = c.store.DeleteEvents(ctx, core.EventFilter{
+		ProviderIDs: []string{c.ID()},
+		CalendarIDs: []string{calendarID},
+		EventIDs:    []string{eventID},
+	})
+	c.invalidate()
+	return nil
+}
+
+// FreeBusy looks up other people's free/busy through the wrapped adapter.
+// Their calendars aren't cached, so this needs a connection.
+func (c *CachedAdapter) FreeBusy(ctx context.Context, emails []string, start, end time.Time) (map[string][]core.BusyPeriod, error) {
+	checker, ok := c.upstream.(core.FreeBusyChecker)
+	if !ok {
+		return nil, core.ErrNotImplemented
+	}
+	if err := c.online(ctx); err != nil {
+		return nil, err
+	}
+	busy, err := checker.FreeBusy(ctx, emails, start, end)
+	return busy, c.track(err)
+}
+
+// SearchEvents searches on the server through the wrapped adapter when it
+// can and the provider is reachable, and in the cached events otherwise.
+func (c *CachedAdapter) SearchEvents(ctx context.Context, query string, opts core.FetchOptions) ([]core.Event, error) {
+	if searcher, ok := c.upstream.(core.EventSearcher); ok && !c.offlineRequested() {
+		var events []core.Event
+		err := c.connect(ctx)
+		if err == nil {
+			events, err = searcher.SearchEvents(ctx, query, opts)
+			if !errors.Is(err, core.ErrNotImplemented) {
+				c.track(err)
+			}
+		}
+		switch {
+		case util.IsNetworkError(err):
+			// Provider unreachable — search what's cached
+		case !errors.Is(err, core.ErrNotImplemented):
+			return events, err
+		}
+	}
+
+	events, err := c.FetchEvents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return eventutil.Matching(events, query), nil
+}
+
+// invalidate marks the cached windows stale, for changes that reach beyond
+// the events at hand (other instances of a series, attendees' copies).
+func (c *CachedAdapter) invalidate() {
+	if state, err := c.store.LoadState(c.ID()); err == nil {
+		state.Invalidate()
+		_ = c.store.SaveState(c.ID(), state)
+	}
+}
+
+// Updates signals whenever a background refresh has written new data.
+func (c *CachedAdapter) Updates() <-chan struct{} {
+	return c.updates
+}
+
+// Wait blocks until all background refreshes have finished.
+func (c *CachedAdapter) Wait() {
+	c.wg.Wait()
+}
+
+// online makes sure the provider can be used for a change: offline mode
+// wasn't requested and the login went through.
+func (c *CachedAdapter) online(ctx context.Context) error {
+	if c.offlineRequested() {
+		return core.ErrOffline
+	}
+	if err := c.connect(ctx); err != nil {
+		if util.IsNetworkError(err) {
+			return fmt.Errorf("%w: %v", core.ErrOffline, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkCache returns ErrNoCache if the provider was never synced.
+func (c *CachedAdapter) checkCache() error {
+	state, err := c.store.LoadState(c.ID())
+	if err != nil {
+		return fmt.Errorf("load cache state: %w", err)
+	}
+	if state.LastSync.IsZero() {
+		return ErrNoCache
+	}
+	return nil
+}
+
+// sync refreshes the cached events in [start, end) from the provider.
+// Providers that report changes update a larger window incrementally;
+// others re-download the range.
+func (c *CachedAdapter) sync(ctx context.Context, start, end time.Time) error {
+	if err := c.connect(ctx); err != nil {
+		return err
+	}
+
+	c.syncMu.Lock()
+	defer c.syncMu.Unlock()
+
+	state, err := c.store.LoadState(c.ID())
+	if err != nil {
+		return fmt.Errorf("load cache state: %w", err)
+	}
+
+	cs, incremental := c.upstream.(core.ChangeSyncer)
+	if incremental {
+		var ws, we time.Time
+		if ws, we, incremental = deltaWindow(state, start, end, time.Now()); incremental {
+			start, end = ws, we
+		}
+	}
+
+	var complete bool
+	if incremental {
+		complete, err = c.syncChanges(ctx, cs, &state, start, end)
+	} else {
+		complete, err = c.syncRange(ctx, state.Calendars, start, end)
+	}
+	if err := c.track(err); err != nil {
+		return err
+	}
+
+	state.Calendars = c.upstream.Calendars()
+	if complete {
+		// Calendars that failed keep their older data, so the range only
+		// counts as synced once every calendar came through.
+		state.MarkSynced(start, end, time.Now())
+	}
+	if err := c.store.SaveState(c.ID(), state); err != nil {
+		return fmt.Errorf("save cache state: %w", err)
+	}
+
+	return nil
+}
+
+// syncRange fetches every event in [start, end) from the provider and
+// replaces the cached events in that range, one calendar at a time. A
+// calendar that fails keeps its cached events; complete is false then.
+// Calendars in known that the provider no longer lists are dropped.
+func (c *CachedAdapter) syncRange(ctx context.Context, known map[string]string, start, end time.Time) (complete bool, err error) {
+	calendars := c.upstream.Calendars()
+
+	var lastErr error
+	synced := 0
+	for calID := range calendars {
+		// Fetch everything so any later combination of filters can be
+		// served from the cache.
+		events, err := c.upstream.FetchEvents(ctx, core.FetchOptions{
+			Start:       start,
+			End:         end,
+			CalendarIDs: []string{calID},
+			RawAllDay:   true,
+			IncludeTypes: []core.EventType{
+				core.TypeDefault,
+				core.TypeOutOfOffice,
+				core.TypeFocusTime,
+				core.TypeWorkLocation,
+			},
+		})
+		if err != nil {
+			lastErr = err
+			continue // keep the cached events
+		}
+
+		filter := core.EventFilter{
+			Start:       start,
+			End:         end,
+			ProviderIDs: []string{c.ID()},
+			CalendarIDs: []string{calID},
+		}
+		if err := c.store.DeleteEvents(ctx, filter); err != nil {
+			return false, fmt.Errorf("clear cached events: %w", err)
+		}
+		if err := c.store.SyncEvents(ctx, splitByCalendar(events)); err != nil {
+			return false, fmt.Errorf("store events: %w", err)
+		}
+		synced++
+	}
+
+	// Drop calendars that no longer exist
+	for calID := range known {
+		if _, exists := calendars[calID]; exists {
+			continue
+		}
+		filter := core.EventFilter{ProviderIDs: []string{c.ID()}, CalendarIDs: []string{calID}}
+		if err := c.store.DeleteEvents(ctx, filter); err != nil {
+			return false, fmt.Errorf("clear cached events: %w", err)
+		}
+	}
+
+	// Same rule as the live adapters: only fail when nothing could be synced
+	if synced == 0 && lastErr != nil {
+		return false, lastErr
+	}
+	return lastErr == nil, nil
+}
+
+// syncChanges brings every calendar's events in [start, end) up to date
+// using the provider's change tracking, falling back to a full sync of a
+// calendar when its token is missing or expired. A calendar that fails
+// keeps its token and cached events; complete is false then.
+func (c *CachedAdapter) syncChanges(ctx context.Context, cs core.ChangeSyncer, state *storage.ProviderState, start, end time.Time) (complete bool, err error) {
+	if !state.DeltaStart.Equal(start) || !state.DeltaEnd.Equal(end) {
+		// Tokens belong to the window they were created for
+		state.Deltas = nil
+		state.DeltaStart, state.DeltaEnd = start, end
+	}
+	if state.Deltas == nil {
+		state.Deltas = make(map[string]string)
+	}
+
+	calendars := c.upstream.Calendars()
+
+	var lastErr error
+	synced := 0
+	for calID := range calendars {
+		changes, err := cs.SyncChanges(ctx, calID, start, end, state.Deltas[calID])
+		if errors.Is(err, core.ErrSyncTokenExpired) {
+			changes, err = cs.SyncChanges(ctx, calID, start, end, "")
+		}
+		if err != nil {
+			lastErr = err
+			continue // keep the old token and cached events
+		}
+
+		if err := c.applyChanges(ctx, calID, start, end, changes); err != nil {
+			return false, err
+		}
+		state.Deltas[calID] = changes.Token
+		synced++
+	}
+
+	// Drop calendars that no longer exist
+	for calID := range state.Deltas {
+		if _, exists := calendars[calID]; exists {
+			continue
+		}
+		delete(state.Deltas, calID)
+		filter := core.EventFilter{ProviderIDs: []string{c.ID()}, CalendarIDs: []string{calID}}
+		if err := c.store.DeleteEvents(ctx, filter); err != nil {
+			return false, fmt.Errorf("clear cached events: %w", err)
+		}
+	}
+
+	// Same rule as the live adapters: only fail when nothing could be synced
+	if synced == 0 && lastErr != nil {
+		return false, lastErr
+	}
+	return lastErr == nil, nil
+}
+
+// applyChanges writes one calendar's changes within [start, end) to storage.
+func (c *CachedAdapter) applyChanges(ctx context.Context, calendarID string, start, end time.Time, changes *core.ChangeSet) error {
+	calFilter := core.EventFilter{
+		ProviderIDs: []string{c.ID()},
+		CalendarIDs: []string{calendarID},
+	}
+
+	if changes.Full {
+		filter := calFilter
+		filter.Start, filter.End = start, end
+		if err := c.store.DeleteEvents(ctx, filter); err != nil {
+			return fmt.Errorf("clear cached events: %w", err)
+		}
+	}
+
+	if len(changes.Deleted) > 0 {
+		filter := calFilter
+		filter.EventIDs = changes.Deleted
+		if err := c.store.DeleteEvents(ctx, filter); err != nil {
+			return fmt.Errorf("delete cached events: %w", err)
+		}
+	}
+
+	// Changes may lie anywhere in the calendar. Keep those inside the window,
+	// plus updates to events already cached (e.g. moved out of the window).
+	var updated []core.Event
+	var outside []string
+	for _, event := range changes.Events {
+		if inWindow(event, start, end) {
+			updated = append(updated, event)
+		} else {
+			outside = append(outside, event.ID)
+		}
+	}
+
+	if len(outside) > 0 {
+		filter := calFilter
+		filter.EventIDs = outside
+		known, err := c.store.ListEvents(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("read cached events: %w", err)
+		}
+		isKnown := make(map[string]bool, len(known))
+		for _, e := range known {
+			isKnown[e.ID] = true
+		}
+		for _, event := range changes.Events {
+			if isKnown[event.ID] && !inWindow(event, start, end) {
+				updated = append(updated, event)
+			}
+		}
+	}
+
+	if err := c.store.SyncEvents(ctx, updated); err != nil {
+		return fmt.Errorf("store events: %w", err)
+	}
+	return nil
+}
+
+// deltaWindow picks the window for an incremental sync covering
+// [start, end): the window of the existing tokens if it still covers the
+// range, otherwise a fresh window around today. ok is false when the range
+// lies outside both and has to be synced on its own.
+func deltaWindow(state storage.ProviderState, start, end, now time.Time) (windowStart, windowEnd time.Time, ok bool) {
+	if len(state.Deltas) > 0 && !start.Before(state.DeltaStart) && !end.After(state.DeltaEnd) {
+		return state.DeltaStart, state.DeltaEnd, true
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	windowStart = today.AddDate(0, 0, -deltaPastDays)
+	windowEnd = today.AddDate(0, 0, deltaFutureDays)
+	if !start.Before(windowStart) && !end.After(windowEnd) {
+		return windowStart, windowEnd, true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// refreshInBackground syncs [start, end) in a goroutine, unless a refresh
+// of the same range is already running.
+func (c *CachedAdapter) refreshInBackground(start, end time.Time) {
+	key := fmt.Sprintf("%d-%d", start.Unix(), end.Unix())
+
+	c.mu.Lock()
+	if c.inflight[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.inflight[key] = true
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.mu.Lock()
+			delete(c.inflight, key)
+			c.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), backgroundTimeout)
+		defer cancel()
+
+		if err := c.sync(ctx, start, end); err != nil {
+			return // keep serving the cached data
+		}
+
+		// Non-blocking: one pending signal is enough
+		select {
+		case c.updates <- struct{}{}:
+		default:
+		}
+	}()
+}
+
+// cachedEvents reads events from storage and applies the same filtering,
+// deduplication and ordering the live adapters do.
+func (c *CachedAdapter) cachedEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	stored, err := c.store.ListEvents(ctx, core.EventFilter{
+		Start:       opts.Start,
+		End:         opts.End,
+		ProviderIDs: []string{c.ID()},
+		CalendarIDs: opts.CalendarIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read cached events: %w", err)
+	}
+
+	var results []core.Event
+	for _, event := range stored {
+		// Treat timed events as all-day if they span the entire viewed range.
+		// Only done here: the cache keeps what the provider reported.
+		if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+			event.IsAllDay = true
+		}
+
+		if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
+			continue
+		}
+		if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
+			continue
+		}
+		if opts.ExcludeAllDay && event.IsAllDay {
+			continue
+		}
+
+		results = append(results, event)
+	}
+
+	results = eventutil.Deduplicate(results)
+	eventutil.SortByStart(results)
+
+	return results, nil
+}
@@ -0,0 +1,49 @@
+package cached
+
+import (
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// splitByCalendar undoes deduplication before storing: an event merged
+// from several calendars becomes one record per calendar, carrying that
+// calendar's status and URL. Filters then apply per calendar on read,
+// exactly like the live adapters do before they deduplicate.
+func splitByCalendar(events []core.Event) []core.Event {
+	var result []core.Event
+	for _, event := range events {
+		if len(event.Calendars) <= 1 {
+			event.Calendars = nil
+			result = append(result, event)
+			continue
+		}
+
+		for _, cr := range event.Calendars {
+			split := event
+			split.Calendar = cr.Calendar
+			split.Status = cr.Status
+			split.URL = cr.URL
+			split.Calendars = nil
+			result = append(result, split)
+		}
+	}
+	return result
+}
+
+// inWindow reports whether an event overlaps [start, end).
+func inWindow(event core.Event, start, end time.Time) bool {
+	return event.Start.Before(end) && (event.End.After(start) || event.Start.Equal(start))
+}
+
+// responseStatus maps a response to the status it results in.
+func responseStatus(r core.ResponseType) core.EventStatus {
+	switch r {
+	case core.ResponseDecline:
+		return core.StatusRejected
+	case core.ResponseTentative:
+		return core.StatusTentative
+	default:
+		return core.StatusAccepted
+	}
+}
@@ -0,0 +1,174 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav/caldav"
+)
+
+// authTransport adds basic or bearer authentication to every request.
+type authTransport struct {
+	username    string
+	password    string
+	bearerToken string
+	base        http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	switch {
+	case t.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	case t.username != "":
+		req.SetBasicAuth(t.username, t.password)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// CalDAVAdapter implements the calendar provider for CalDAV servers
+// (Fastmail, Nextcloud, iCloud, Radicale, ...).
+type CalDAVAdapter struct {
+	id        string
+	name      string
+	endpoint  string
+	email     string
+	calendars map[string]string
+
+	http    *http.Client
+	client  *caldav.Client
+	baseURL *url.URL
+}
+
+// NewCalDAVAdapter creates a CalDAV adapter. endpoint is the server's
+// CalDAV URL, or just its domain to use DNS/.well-known discovery.
+// Authentication uses bearerToken when set, otherwise username/password
+// (iCloud and Fastmail need an app-specific password). email identifies
+// the user among event attendees and defaults to the username.
+func NewCalDAVAdapter(id, name, endpoint, username, password, bearerToken, email string) *CalDAVAdapter {
+	if email == "" && strings.Contains(username, "@") {
+		email = username
+	}
+	return &CalDAVAdapter{
+		id:        id,
+		name:      name,
+		endpoint:  endpoint,
+		email:     email,
+		calendars: make(map[string]string),
+		http: &http.Client{
+			Transport: &authTransport{
+				username:    username,
+				password:    password,
+				bearerToken: bearerToken,
+				base:        http.DefaultTransport,
+			},
+		},
+	}
+}
+
+func (c *CalDAVAdapter) ID() string   { return c.id }
+func (c *CalDAVAdapter) Name() string { return c.name }
+
+// Login connects to the server and discovers the user's calendars.
+func (c *CalDAVAdapter) Login(ctx context.Context) error {
+	if c.endpoint == "" {
+		return fmt.Errorf("no CalDAV url configured")
+	}
+
+	endpoint := c.endpoint
+	if !strings.Contains(endpoint, "://") {
+		discovered, err := caldav.DiscoverContextURL(ctx, endpoint)
+		if err != nil {
+			return fmt.Errorf("discover CalDAV server for %s: %w", endpoint, err)
+		}
+		endpoint = discovered
+	}
+
+	baseURL, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid CalDAV url %q: %w", endpoint, err)
+	}
+
+	client, err := caldav.NewClient(c.http, endpoint)
+	if err != nil {
+		return fmt.Errorf("create CalDAV client: %w", err)
+	}
+	c.client = client
+	c.baseURL = baseURL
+
+	if err := c.loadCalendarList(ctx); err != nil {
+		return fmt.Errorf("load calendar list: %w", err)
+	}
+
+	return nil
+}
+
+// Calendars returns all available calendars (ID → Name).
+// Calendar IDs are the collection paths on the server.
+func (c *CalDAVAdapter) Calendars() map[string]string {
+	return c.calendars
+}
+
+// loadCalendarList finds the user's calendar home and lists the calendars
+// in it that hold events (task lists and address books are skipped).
+func (c *CalDAVAdapter) loadCalendarList(ctx context.Context) error {
+	principal, err := c.client.FindCurrentUserPrincipal(ctx)
+	if err != nil {
+		return fmt.Errorf("find user principal: %w", err)
+	}
+
+	homeSet, err := c.client.FindCalendarHomeSet(ctx, principal)
+	if err != nil {
+		return fmt.Errorf("find calendar home: %w", err)
+	}
+
+	calendars, err := c.client.FindCalendars(ctx, homeSet)
+	if err != nil {
+		return err
+	}
+
+	for _, cal := range calendars {
+		if !supportsEvents(cal) {
+			continue
+		}
+		name := cal.Name
+		if name == "" {
+			name = path.Base(strings.TrimSuffix(cal.Path, "/"))
+		}
+		c.calendars[cal.Path] = name
+	}
+
+	return nil
+}
+
+// supportsEvents reports whether a calendar can hold VEVENTs.
+// An empty component set means the server accepts any component.
+func supportsEvents(cal caldav.Calendar) bool {
+	if len(cal.SupportedComponentSet) == 0 {
+		return true
+	}
+	for _, comp := range cal.SupportedComponentSet {
+		if strings.EqualFold(comp, ical.CompEvent) {
+			return true
+		}
+	}
+	return false
+}
+
+// self returns the addresses that identify the user in attendee lists.
+func (c *CalDAVAdapter) self() []string {
+	if c.email == "" {
+		return nil
+	}
+	return []string{c.email}
+}
+
+// objectURL resolves a calendar object path against the server URL.
+func (c *CalDAVAdapter) objectURL(objectPath string) string {
+	return c.baseURL.ResolveReference(&url.URL{Path: objectPath}).String()
+}
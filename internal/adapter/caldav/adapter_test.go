@@ -0,0 +1,394 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+	"github.com/theakshaypant/tsk/internal/icalendar"
+)
+
+const (
+	principalPath = "/alice/"
+	homeSetPath   = "/alice/calendars/"
+	workPath      = "/alice/calendars/work/"
+	tasksPath     = "/alice/calendars/tasks/"
+)
+
+// fakeBackend is an in-memory CalDAV server backend.
+type fakeBackend struct {
+	mu      sync.Mutex
+	objects map[string]*caldav.CalendarObject
+	version int
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{objects: make(map[string]*caldav.CalendarObject)}
+}
+
+func (b *fakeBackend) CurrentUserPrincipal(ctx context.Context) (string, error) {
+	return principalPath, nil
+}
+
+func (b *fakeBackend) CalendarHomeSetPath(ctx context.Context) (string, error) {
+	return homeSetPath, nil
+}
+
+func (b *fakeBackend) CreateCalendar(ctx context.Context, calendar *caldav.Calendar) error {
+	return webdav.NewHTTPError(http.StatusForbidden, nil)
+}
+
+func (b *fakeBackend) ListCalendars(ctx context.Context) ([]caldav.Calendar, error) {
+	return []caldav.Calendar{
+		{Path: workPath, Name: "Work", SupportedComponentSet: []string{ical.CompEvent}},
+		{Path: tasksPath, Name: "Tasks", SupportedComponentSet: []string{ical.CompToDo}},
+	}, nil
+}
+
+func (b *fakeBackend) GetCalendar(ctx context.Context, path string) (*caldav.Calendar, error) {
+	cals, _ := b.ListCalendars(ctx)
+	for _, cal := range cals {
+		if cal.Path == path {
+			return &cal, nil
+		}
+	}
+	return nil, webdav.NewHTTPError(http.StatusNotFound, nil)
+}
+
+func (b *fakeBackend) GetCalendarObject(ctx context.Context, path string, req *caldav.CalendarCompRequest) (*caldav.CalendarObject, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	obj, ok := b.objects[path]
+	if !ok {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, nil)
+	}
+	return obj, nil
+}
+
+func (b *fakeBackend) ListCalendarObjects(ctx context.Context, path string, req *caldav.CalendarCompRequest) ([]caldav.CalendarObject, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []caldav.CalendarObject
+	for p, obj := range b.objects {
+		if strings.HasPrefix(p, path) {
+			result = append(result, *obj)
+		}
+	}
+	return result, nil
+}
+
+func (b *fakeBackend) QueryCalendarObjects(ctx context.Context, path string, query *caldav.CalendarQuery) ([]caldav.CalendarObject, error) {
+	objects, err := b.ListCalendarObjects(ctx, path, &query.CompRequest)
+	if err != nil {
+		return nil, err
+	}
+	return caldav.Filter(query, objects)
+}
+
+func (b *fakeBackend) PutCalendarObject(ctx context.Context, path string, cal *ical.Calendar, opts *caldav.PutCalendarObjectOptions) (*caldav.CalendarObject, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if existing, ok := b.objects[path]; ok && opts.IfMatch.IsSet() {
+		if match, err := opts.IfMatch.MatchETag(existing.ETag); err != nil || !match {
+			return nil, webdav.NewHTTPError(http.StatusPreconditionFailed, nil)
+		}
+	}
+
+	b.version++
+	obj := &caldav.CalendarObject{
+		Path:    path,
+		ModTime: time.Now(),
+		ETag:    fmt.Sprintf("v%d", b.version),
+		Data:    cal,
+	}
+	b.objects[path] = obj
+	return obj, nil
+}
+
+func (b *fakeBackend) DeleteCalendarObject(ctx context.Context, path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.objects, path)
+	return nil
+}
+
+// put stores an iCalendar object given as text.
+func (b *fakeBackend) put(t *testing.T, path, data string) {
+	t.Helper()
+	cal, err := ical.NewDecoder(strings.NewReader(strings.ReplaceAll(data, "\n", "\r\n"))).Decode()
+	if err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+	b.PutCalendarObject(context.Background(), path, cal, &caldav.PutCalendarObjectOptions{})
+}
+
+// startServer serves the backend, requiring the given Authorization header.
+func startServer(t *testing.T, backend *fakeBackend, authorization string) *httptest.Server {
+	t.Helper()
+	handler := &caldav.Handler{Backend: backend}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != authorization {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+const meeting = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//test//EN
+BEGIN:VEVENT
+UID:meeting-1
+DTSTAMP:20260301T000000Z
+DTSTART:20260310T140000Z
+DTEND:20260310T150000Z
+SUMMARY:Planning
+LOCATION:Room 1
+ORGANIZER:mailto:bob@example.com
+ATTENDEE;PARTSTAT=ACCEPTED:mailto:bob@example.com
+ATTENDEE;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:alice@example.com
+END:VEVENT
+END:VCALENDAR
+`
+
+const ownEvent = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//test//EN
+BEGIN:VEVENT
+UID:own-1
+DTSTAMP:20260301T000000Z
+DTSTART:20260311T090000Z
+DTEND:20260311T093000Z
+SUMMARY:Focus
+ORGANIZER:mailto:alice@example.com
+END:VEVENT
+END:VCALENDAR
+`
+
+const laterEvent = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//test//EN
+BEGIN:VEVENT
+UID:later-1
+DTSTAMP:20260301T000000Z
+DTSTART;VALUE=DATE:20260401
+SUMMARY:Holiday
+END:VEVENT
+END:VCALENDAR
+`
+
+func newTestAdapter(t *testing.T, authorization string, username, password, token string) (*CalDAVAdapter, *fakeBackend) {
+	t.Helper()
+	backend := newFakeBackend()
+	backend.put(t, workPath+"meeting.ics", meeting)
+	backend.put(t, workPath+"own.ics", ownEvent)
+	backend.put(t, workPath+"later.ics", laterEvent)
+
+	server := startServer(t, backend, authorization)
+	adapter := NewCalDAVAdapter("dav", "DAV", server.URL+"/", username, password, token, "")
+	return adapter, backend
+}
+
+func TestLoginDiscoversEventCalendars(t *testing.T) {
+	adapter, _ := newTestAdapter(t, "Basic YWxpY2VAZXhhbXBsZS5jb206c2VjcmV0", "alice@example.com", "secret", "")
+
+	if err := adapter.Login(context.Background()); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	calendars := adapter.Calendars()
+	if len(calendars) != 1 || calendars[workPath] != "Work" {
+		t.Errorf("Calendars() = %v, want only %s (Work)", calendars, workPath)
+	}
+}
+
+func TestLoginBearerToken(t *testing.T) {
+	adapter, _ := newTestAdapter(t, "Bearer tok123", "", "", "tok123")
+	if err := adapter.Login(context.Background()); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+}
+
+func TestLoginRejected(t *testing.T) {
+	adapter, _ := newTestAdapter(t, "Bearer tok123", "alice@example.com", "wrong", "")
+	if err := adapter.Login(context.Background()); err == nil {
+		t.Fatal("Login succeeded with wrong credentials")
+	}
+}
+
+func TestFetchEvents(t *testing.T) {
+	adapter, _ := newTestAdapter(t, "Bearer tok", "alice@example.com", "", "tok")
+	if err := adapter.Login(context.Background()); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	start := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)
+	events, err := adapter.FetchEvents(context.Background(), core.DefaultFetchOptions(start, end))
+	if err != nil {
+		t.Fatalf("FetchEvents: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+
+	got := events[0]
+	if got.ID != "meeting-1" || got.Title != "Planning" || got.Location != "Room 1" {
+		t.Errorf("first event = %+v", got)
+	}
+	if got.ProviderID != "dav" || got.Calendar.ID != workPath || got.Calendar.Name != "Work" {
+		t.Errorf("first event source = %s %+v", got.ProviderID, got.Calendar)
+	}
+	if got.Status != core.StatusAwaiting {
+		t.Errorf("invited event status = %v, want awaiting", got.Status)
+	}
+	if got.Organizer != "bob@example.com" {
+		t.Errorf("organizer = %q", got.Organizer)
+	}
+	if summary := got.AttendeeSummary(); len(got.Attendees) != 2 || summary.Accepted != 1 || summary.Pending != 1 {
+		t.Errorf("attendees = %+v, summary %+v", got.Attendees, summary)
+	}
+	if !got.Start.Equal(time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)) || got.Duration() != time.Hour {
+		t.Errorf("timing = %v + %v", got.Start, got.Duration())
+	}
+
+	if events[1].ID != "own-1" || events[1].Status != core.StatusAccepted {
+		t.Errorf("own event = %s status %v, want own-1 accepted", events[1].ID, events[1].Status)
+	}
+}
+
+func TestRespondToEvent(t *testing.T) {
+	adapter, backend := newTestAdapter(t, "Bearer tok", "alice@example.com", "", "tok")
+	ctx := context.Background()
+	if err := adapter.Login(ctx); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	err := adapter.RespondToEvent(ctx, workPath, "meeting-1", core.RespondOptions{Response: core.ResponseDecline})
+	if err != nil {
+		t.Fatalf("RespondToEvent: %v", err)
+	}
+
+	obj := backend.objects[workPath+"meeting.ics"]
+	vevent := icalendar.Master(obj.Data, "meeting-1")
+	for _, attendee := range vevent.Props.Values(ical.PropAttendee) {
+		want := "ACCEPTED"
+		if strings.Contains(attendee.Value, "alice") {
+			want = "DECLINED"
+		}
+		if got := attendee.Params.Get(ical.ParamParticipationStatus); got != want {
+			t.Errorf("%s PARTSTAT = %s, want %s", attendee.Value, got, want)
+		}
+	}
+
+	if err := adapter.RespondToEvent(ctx, workPath, "own-1", core.RespondOptions{}); err != core.ErrIsOrganizer {
+		t.Errorf("responding to own event: got %v, want ErrIsOrganizer", err)
+	}
+	if err := adapter.RespondToEvent(ctx, workPath, "later-1", core.RespondOptions{}); err != core.ErrNotAttendee {
+		t.Errorf("responding to uninvited event: got %v, want ErrNotAttendee", err)
+	}
+}
+
+const weekly = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//test//EN
+BEGIN:VEVENT
+UID:weekly-1
+DTSTAMP:20260301T000000Z
+DTSTART;TZID=Europe/Berlin:20260302T100000
+DTEND;TZID=Europe/Berlin:20260302T103000
+RRULE:FREQ=WEEKLY
+SUMMARY:Standup
+ORGANIZER:mailto:bob@example.com
+ATTENDEE;PARTSTAT=NEEDS-ACTION:mailto:alice@example.com
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestRespondToSingleInstance(t *testing.T) {
+	adapter, backend := newTestAdapter(t, "Bearer tok", "alice@example.com", "", "tok")
+	backend.put(t, workPath+"weekly.ics", weekly)
+	ctx := context.Background()
+	if err := adapter.Login(ctx); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	instance := recurrence.InstanceID("weekly-1", time.Date(2026, 3, 9, 10, 0, 0, 0, berlin))
+
+	err = adapter.RespondToEvent(ctx, workPath, instance, core.RespondOptions{
+		Response:       core.ResponseTentative,
+		RecurringScope: core.RecurringScopeThisInstance,
+	})
+	if err != nil {
+		t.Fatalf("RespondToEvent: %v", err)
+	}
+
+	cal := backend.objects[workPath+"weekly.ics"].Data
+	overrides := icalendar.Overrides(cal, "weekly-1")
+	if len(overrides) != 1 {
+		t.Fatalf("got %d overrides, want 1", len(overrides))
+	}
+
+	override := overrides[0]
+	if rid := override.Props.Get(ical.PropRecurrenceID); rid.Value != "20260309T100000" || rid.Params.Get(ical.PropTimezoneID) != "Europe/Berlin" {
+		t.Errorf("RECURRENCE-ID = %v %s", rid.Params, rid.Value)
+	}
+	if override.Props.Get(ical.PropRecurrenceRule) != nil {
+		t.Error("override kept the RRULE")
+	}
+	if end := override.Props.Get(ical.PropDateTimeEnd); end.Value != "20260309T103000" {
+		t.Errorf("DTEND = %s", end.Value)
+	}
+	event, err := icalendar.ParseEvent(override, []string{"alice@example.com"})
+	if err != nil || event.Status != core.StatusTentative || event.ID != instance {
+		t.Errorf("override = %+v, %v", event, err)
+	}
+
+	master := icalendar.Master(cal, "weekly-1")
+	if got := master.Props.Get(ical.PropAttendee).Params.Get(ical.ParamParticipationStatus); got != "NEEDS-ACTION" {
+		t.Errorf("master PARTSTAT = %s, want unchanged", got)
+	}
+}
+
+func TestRespondConflict(t *testing.T) {
+	adapter, backend := newTestAdapter(t, "Bearer tok", "alice@example.com", "", "tok")
+	ctx := context.Background()
+	if err := adapter.Login(ctx); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	obj, err := adapter.findObject(ctx, workPath, "meeting-1")
+	if err != nil {
+		t.Fatalf("findObject: %v", err)
+	}
+
+	// Someone else changes the event in the meantime
+	backend.put(t, workPath+"meeting.ics", meeting)
+
+	if err := adapter.putObject(ctx, obj); err == nil {
+		t.Error("putObject overwrote a newer version")
+	}
+}
@@ -0,0 +1,219 @@
+package caldav
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav/caldav"
+
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+	"github.com/theakshaypant/tsk/internal/icalendar"
+)
+
+// FetchEvents retrieves events from the user's calendars matching the given options.
+func (c *CalDAVAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	var results []core.Event
+
+	calendarIDs := opts.CalendarIDs
+	if len(calendarIDs) == 0 {
+		for calID := range c.calendars {
+			calendarIDs = append(calendarIDs, calID)
+		}
+	}
+
+	var lastErr error
+	fetched := 0
+	for _, calID := range calendarIDs {
+		if _, exists := c.calendars[calID]; !exists {
+			continue
+		}
+		events, err := c.fetchEventsFromCalendar(ctx, calID, opts)
+		if err != nil {
+			lastErr = err
+			continue // skip failed calendars
+		}
+		fetched++
+		results = append(results, events...)
+	}
+
+	// Every calendar failed — report it instead of returning an empty list
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	results = eventutil.Deduplicate(results)
+	eventutil.SortByStart(results)
+
+	return results, nil
+}
+
+// fetchEventsFromCalendar runs a calendar-query REPORT for the time range,
+// asking the server to expand recurring events into instances. Servers
+// that ignore the request return the master events, which are expanded
+// locally instead.
+func (c *CalDAVAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID string, opts core.FetchOptions) ([]core.Event, error) {
+	start := opts.Start.UTC()
+	end := opts.End.UTC()
+
+	query := &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{
+			Name:     ical.CompCalendar,
+			AllProps: true,
+			AllComps: true,
+			Expand:   &caldav.CalendarExpandRequest{Start: start, End: end},
+		},
+		CompFilter: caldav.CompFilter{
+			Name: ical.CompCalendar,
+			Comps: []caldav.CompFilter{{
+				Name:  ical.CompEvent,
+				Start: start,
+				End:   end,
+			}},
+		},
+	}
+
+	objects, err := c.client.QueryCalendar(ctx, calendarID, query)
+	if err != nil {
+		return nil, fmt.Errorf("query calendar: %w", err)
+	}
+
+	calendar := core.Calendar{ID: calendarID, Name: c.calendars[calendarID]}
+	var results []core.Event
+
+	for _, obj := range objects {
+		for _, event := range icalendar.Events(obj.Data, c.self(), opts) {
+			event.ProviderID = c.ID()
+			event.Calendar = calendar
+
+			// The object matched, but not every component in it has to
+			if !event.Start.Before(opts.End) || (!event.End.After(opts.Start) && !event.Start.Equal(opts.Start)) {
+				continue
+			}
+
+			// Treat timed events as all-day if they span the entire viewed day
+			if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+				event.IsAllDay = true
+			}
+
+			if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
+				continue
+			}
+			if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
+				continue
+			}
+			if opts.ExcludeAllDay && event.IsAllDay {
+				continue
+			}
+
+			results = append(results, event)
+		}
+	}
+
+	return results, nil
+}
+
+// RespondToEvent responds to an event invitation by updating the user's
+// PARTSTAT in the event and writing it back. A CalDAV server with
+// scheduling support notifies the organizer.
+func (c *CalDAVAdapter) RespondToEvent(ctx context.Context, calendarID, eventID string, opts core.RespondOptions) error {
+	if opts.ProposedTime != nil {
+		return fmt.Errorf("proposing a new time is not supported for CalDAV calendars")
+	}
+	if opts.Comment != "" {
+		return fmt.Errorf("comments are not supported for CalDAV calendars")
+	}
+	if _, exists := c.calendars[calendarID]; !exists {
+		return fmt.Errorf("unknown calendar %s", calendarID)
+	}
+
+	uid, _ := recurrence.SplitID(eventID)
+	obj, err := c.findObject(ctx, calendarID, uid)
+	if err != nil {
+		return fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	if err := icalendar.Respond(obj.Data, eventID, c.self(), opts.Response, opts.RecurringScope); err != nil {
+		return err
+	}
+
+	if err := c.putObject(ctx, obj); err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+
+	return nil
+}
+
+// findObject looks up the calendar object holding the event with the given UID.
+func (c *CalDAVAdapter) findObject(ctx context.Context, calendarID, uid string) (*caldav.CalendarObject, error) {
+	query := &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{
+			Name:     ical.CompCalendar,
+			AllProps: true,
+			AllComps: true,
+		},
+		CompFilter: caldav.CompFilter{
+			Name: ical.CompCalendar,
+			Comps: []caldav.CompFilter{{
+				Name: ical.CompEvent,
+				Props: []caldav.PropFilter{{
+					Name:      ical.PropUID,
+					TextMatch: &caldav.TextMatch{Text: uid},
+				}},
+			}},
+		},
+	}
+
+	objects, err := c.client.QueryCalendar(ctx, calendarID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// text-match is a substring match, so check for the exact UID
+	for i := range objects {
+		for _, vevent := range objects[i].Data.Events() {
+			if id, _ := vevent.Props.Text(ical.PropUID); id == uid {
+				return &objects[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("event %s not found", uid)
+}
+
+// putObject writes a calendar object back to the server. The request is
+// conditional on the ETag it was read with, so a concurrent change on the
+// server isn't overwritten.
+func (c *CalDAVAdapter) putObject(ctx context.Context, obj *caldav.CalendarObject) error {
+	var buf bytes.Buffer
+	if err := ical.NewEncoder(&buf).Encode(obj.Data); err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.objectURL(obj.Path), &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ical.MIMEType+"; charset=utf-8")
+	if obj.ETag != "" {
+		req.Header.Set("If-Match", strconv.Quote(obj.ETag))
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return fmt.Errorf("the event was changed on the server, refresh and try again")
+	case resp.StatusCode/100 != 2:
+		return fmt.Errorf("server returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package composite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// CompositeAdapter combines several accounts into one adapter.
+// Reads fan out to every account and are merged; writes are routed back to
+// the account that owns the calendar.
+//
+// Calendar IDs are qualified with the account ID ("work/primary") so that
+// calendars of different accounts never collide.
+type CompositeAdapter struct {
+	id       string
+	name     string
+	adapters []core.CalendarAdapter
+	// skipped holds the login errors of accounts left out by Login
+	skipped []error
+
+	updatesOnce sync.Once
+	updates     chan struct{}
+}
+
+func NewCompositeAdapter(id, name string, adapters ...core.CalendarAdapter) *CompositeAdapter {
+	return &CompositeAdapter{
+		id:       id,
+		name:     name,
+		adapters: adapters,
+		updates:  make(chan struct{}, 1),
+	}
+}
+
+func (c *CompositeAdapter) ID() string   { return c.id }
+func (c *CompositeAdapter) Name() string { return c.name }
+
+// Login logs in to every account. Accounts that fail are left out of all
+// further calls and reported by Skipped; like fanOut, Login only fails
+// when no account could be used.
+func (c *CompositeAdapter) Login(ctx context.Context) error {
+	var active []core.CalendarAdapter
+	var lastErr error
+	for _, a := range c.adapters {
+		if err := a.Login(ctx); err != nil {
+			lastErr = fmt.Errorf("account %s: %w", a.ID(), err)
+			c.skipped = append(c.skipped, lastErr)
+			continue
+		}
+		active = append(active, a)
+	}
+
+	if len(active) == 0 && lastErr != nil {
+		return lastErr
+	}
+	c.adapters = active
+	return nil
+}
+
+// Skipped returns the login errors of the accounts Login left out.
+func (c *CompositeAdapter) Skipped() []error {
+	return c.skipped
+}
+
+// Calendars returns the calendars of all accounts, keyed by qualified ID.
+func (c *CompositeAdapter) Calendars() map[string]string {
+	calendars := make(map[string]string)
+	for _, a := range c.adapters {
+		for id, name := range a.Calendars() {
+			calendars[qualify(a.ID(), id)] = name
+		}
+	}
+	return calendars
+}
+
+// FetchEvents fetches from all accounts in parallel and merges the results.
+func (c *CompositeAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	return c.fanOut(ctx, opts, func(a core.CalendarAdapter, opts core.FetchOptions) ([]core.Event, error) {
+		return a.FetchEvents(ctx, opts)
+	})
+}
+
+// RespondToEvent forwards the response to the account owning the calendar.
+func (c *CompositeAdapter) RespondToEvent(ctx context.Context, calendarID, eventID string, opts core.RespondOptions) error {
+	a, calID, err := c.route(calendarID)
+	if err != nil {
+		return err
+	}
+	return a.RespondToEvent(ctx, calID, eventID, opts)
+}
+
+// CanCreate reports whether the account owning the calendar can create
+// events. CompositeAdapter itself always implements core.EventCreator.
+func (c *CompositeAdapter) CanCreate(calendarID string) bool {
+	a, calID, err := c.route(calendarID)
+	if err != nil {
+		return false
+	}
+	if p, ok := a.(createProbe); ok {
+		return p.CanCreate(calID)
+	}
+	_, ok := a.(core.EventCreator)
+	return ok
+}
+
+// CreateEvent forwards the event to the account owning the calendar.
+func (c *CompositeAdapter) CreateEvent(ctx context.Context, calendarID string, draft core.EventDraft) (core.Event, error) {
+	a, calID, err := c.route(calendarID)
+	if err != nil {
+		return core.Event{}, err
+	}
+	creator, ok := a.(core.EventCreator)
+	if !ok {
+		return core.Event{}, core.ErrNotImplemented
+	}
+	event, err := creator.CreateEvent(ctx, calID, draft)
+	if err != nil {
+		return core.Event{}, err
+	}
+	return qualifyEvents(a.ID(), []core.Event{event})[0], nil
+}
+
+// UpdateEvent forwards the change to the account owning the calendar.
+func (c *CompositeAdapter) UpdateEvent(ctx context.Context, calendarID, eventID string, update core.EventUpdate) (core.Event, error) {
+	a, calID, err := c.route(calendarID)
+	if err != nil {
+		return core.Event{}, err
+	}
+	updater, ok := a.(core.EventUpdater)
+	if !ok {
+		return core.Event{}, core.ErrNotImplemented
+	}
+	event, err := updater.UpdateEvent(ctx, calID, eventID, update)
+	if err != nil {
+		return core.Event{}, err
+	}
+	return qualifyEvents(a.ID(), []core.Event{event})[0], nil
+}
+
+// DeleteEvent forwards the deletion to the account owning the calendar.
+func (c *CompositeAdapter) DeleteEvent(ctx context.Context, calendarID, eventID string, opts core.DeleteOptions) error {
+	a, calID, err := c.route(calendarID)
+	if err != nil {
+		return err
+	}
+	deleter, ok := a.(core.EventDeleter)
+	if !ok {
+		return core.ErrNotImplemented
+	}
+	return deleter.DeleteEvent(ctx, calID, eventID, opts)
+}
+
+// FreeBusy asks each account in turn about the addresses the accounts
+// before it couldn't look up, so a colleague is found through whichever
+// account shares a directory with them.
+func (c *CompositeAdapter) FreeBusy(ctx context.Context, emails []string, start, end time.Time) (map[string][]core.BusyPeriod, error) {
+	busy := make(map[string][]core.BusyPeriod)
+	remaining := emails
+	var lastErr error
+	asked := 0
+	for _, a := range c.adapters {
+		if len(remaining) == 0 {
+			break
+		}
+		checker, ok := a.(core.FreeBusyChecker)
+		if !ok {
+			continue
+		}
+		found, err := checker.FreeBusy(ctx, remaining, start, end)
+		if errors.Is(err, core.ErrNotImplemented) {
+			continue
+		}
+		asked++
+		if err != nil {
+			lastErr = fmt.Errorf("account %s: %w", a.ID(), err)
+			continue
+		}
+
+		var missing []string
+		for _, email := range remaining {
+			if periods, ok := found[email]; ok {
+				busy[email] = periods
+			} else {
+				missing = append(missing, email)
+			}
+		}
+		remaining = missing
+	}
+
+	if asked == 0 {
+		return nil, core.ErrNotImplemented
+	}
+	if len(busy) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return busy, nil
+}
+
+// SearchEvents searches all accounts in parallel and merges the results.
+// Accounts that can't search on the server are searched locally.
+func (c *CompositeAdapter) SearchEvents(ctx context.Context, query string, opts core.FetchOptions) ([]core.Event, error) {
+	return c.fanOut(ctx, opts, func(a core.CalendarAdapter, opts core.FetchOptions) ([]core.Event, error) {
+		if searcher, ok := a.(core.EventSearcher); ok {
+			events, err := searcher.SearchEvents(ctx, query, opts)
+			if !errors.Is(err, core.ErrNotImplemented) {
+				return events, err
+			}
+		}
+		events, err := a.FetchEvents(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return eventutil.Matching(events, query), nil
+	})
+}
+
+// fanOut runs fetch for every account selected by opts.CalendarIDs, in
+// parallel, and merges the events sorted by start time, folding copies of
+// the same event in different accounts into one. Like the single
+// adapters, it only fails when no account returned events.
+func (c *CompositeAdapter) fanOut(ctx context.Context, opts core.FetchOptions, fetch func(core.CalendarAdapter, core.FetchOptions) ([]core.Event, error)) ([]core.Event, error) {
+	type result struct {
+		events []core.Event
+		err    error
+	}
+
+	results := make([]result, len(c.adapters))
+	var wg sync.WaitGroup
+	for i, a := range c.adapters {
+		accountOpts, ok := accountOptions(a.ID(), opts)
+		if !ok {
+			continue // no calendars of this account selected
+		}
+
+		wg.Add(1)
+		go func(i int, a core.CalendarAdapter) {
+			defer wg.Done()
+			events, err := fetch(a, accountOpts)
+			if err != nil {
+				results[i].err = fmt.Errorf("account %s: %w", a.ID(), err)
+				return
+			}
+			results[i].events = qualifyEvents(a.ID(), events)
+		}(i, a)
+	}
+	wg.Wait()
+
+	var merged []core.Event
+	var lastErr error
+	fetched := 0
+	for _, r := range results {
+		if r.err != nil {
+			lastErr = r.err
+			continue
+		}
+		fetched++
+		merged = append(merged, r.events...)
+	}
+
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	// Results are merged in account order, so the first account listed
+	// wins when the same event shows up in several of them
+	merged = mergeDuplicates(merged)
+	eventutil.SortByStart(merged)
+	return merged, nil
+}
+
+// route finds the account owning a qualified calendar ID.
+func (c *CompositeAdapter) route(calendarID string) (core.CalendarAdapter, string, error) {
+	accountID, calID, ok := splitCalendarID(calendarID)
+	if !ok {
+		return nil, "", fmt.Errorf("calendar %q has no account prefix (expected account/calendar)", calendarID)
+	}
+	for _, a := range c.adapters {
+		if a.ID() == accountID {
+			return a, calID, nil
+		}
+	}
+	return nil, "", fmt.Errorf("unknown account %q", accountID)
+}
+
+// Optional capabilities of the wrapped adapters (see the cached adapter)
+type refresher interface {
+	Refresh(ctx context.Context, opts core.FetchOptions) ([]core.Event, error)
+}
+
+type cacheUpdater interface {
+	Updates() <-chan struct{}
+}
+
+type waiter interface {
+	Wait()
+}
+
+type createProbe interface {
+	CanCreate(calendarID string) bool
+}
+
+type offlineSwitch interface {
+	SetOffline(offline bool)
+	Offline() bool
+	SyncedAt(start, end time.Time) (time.Time, bool)
+}
+
+// Refresh syncs every account from its source, bypassing caches.
+func (c *CompositeAdapter) Refresh(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	return c.fanOut(ctx, opts, func(a core.CalendarAdapter, opts core.FetchOptions) ([]core.Event, error) {
+		if r, ok := a.(refresher); ok {
+			return r.Refresh(ctx, opts)
+		}
+		return a.FetchEvents(ctx, opts)
+	})
+}
+
+// Updates signals whenever any account has refreshed its cached data.
+func (c *CompositeAdapter) Updates() <-chan struct{} {
+	c.updatesOnce.Do(func() {
+		for _, a := range c.adapters {
+			u, ok := a.(cacheUpdater)
+			if !ok {
+				continue
+			}
+			go func(updates <-chan struct{}) {
+				for range updates {
+					select {
+					case c.updates <- struct{}{}:
+					default:
+					}
+				}
+			}(u.Updates())
+		}
+	})
+	return c.updates
+}
+
+// Wait blocks until background work of all accounts has finished.
+func (c *CompositeAdapter) Wait() {
+	for _, a := range c.adapters {
+		if w, ok := a.(waiter); ok {
+			w.Wait()
+		}
+	}
+}
+
+// SetOffline switches offline mode on or off for every account.
+func (c *CompositeAdapter) SetOffline(offline bool) {
+	for _, a := range c.adapters {
+		if o, ok := a.(offlineSwitch); ok {
+			o.SetOffline(offline)
+		}
+	}
+}
+
+// Offline reports whether any account is serving cached data only.
+func (c *CompositeAdapter) Offline() bool {
+	for _, a := range c.adapters {
+		if o, ok := a.(offlineSwitch); ok && o.Offline() {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncedAt returns the oldest sync time among the offline accounts.
+func (c *CompositeAdapter) SyncedAt(start, end time.Time) (time.Time, bool) {
+	var oldest time.Time
+	found := false
+	for _, a := range c.adapters {
+		o, ok := a.(offlineSwitch)
+		if !ok || !o.Offline() {
+			continue
+		}
+		if at, ok := o.SyncedAt(start, end); ok && (!found || at.Before(oldest)) {
+			oldest = at
+			found = true
+		}
+	}
+	return oldest, found
+}
@@ -0,0 +1,147 @@
+package composite
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// timeTolerance is how far the start and end of two copies may differ and
+// still match without a shared ICalUID. Providers round differently, and
+// a forwarded copy is sometimes created a few minutes off.
+const timeTolerance = 5 * time.Minute
+
+// titlePrefixes are added by mail clients and invitation mails to copies
+// of an event, and ignored when comparing titles.
+var titlePrefixes = []string{"fw:", "fwd:", "re:", "invitation:", "updated invitation:"}
+
+// mergeDuplicates merges events that appear in more than one account, e.g.
+// a meeting forwarded from a Google to an Outlook calendar. Events match on
+// their ICalUID and start first, since every instance of a recurring
+// series shares the series' ICalUID. Otherwise they match on title, start,
+// end and organizer, allowing for small differences (see titleKey and
+// timeTolerance). The first occurrence is kept; the others add their
+// calendars, with their own status, to its Calendars.
+//
+// Events of the same account are already deduplicated by the adapter and
+// are never merged here.
+func mergeDuplicates(events []core.Event) []core.Event {
+	byUID := make(map[string]int)
+	byTitle := make(map[string][]int)
+	var result []core.Event
+
+	for _, event := range events {
+		idx := -1
+		key := uidKey(event)
+		if key != "" {
+			if i, ok := byUID[key]; ok && result[i].ProviderID != event.ProviderID {
+				idx = i
+			}
+		}
+
+		title := titleKey(event.Title)
+		if idx < 0 {
+			for _, i := range byTitle[title] {
+				if result[i].ProviderID != event.ProviderID && sameTimes(result[i], event) && sameOrganizer(result[i], event) {
+					idx = i
+					break
+				}
+			}
+		}
+
+		if idx >= 0 {
+			result[idx].Calendars = append(calendarResponses(result[idx]), calendarResponses(event)...)
+			continue
+		}
+
+		result = append(result, event)
+		if key != "" {
+			if _, ok := byUID[key]; !ok {
+				byUID[key] = len(result) - 1
+			}
+		}
+		byTitle[title] = append(byTitle[title], len(result)-1)
+	}
+
+	return result
+}
+
+// uidKey identifies one occurrence of an event by its ICalUID and start.
+// It is empty for events without an ICalUID.
+func uidKey(e core.Event) string {
+	if e.DedupeKey == "" {
+		return ""
+	}
+	return e.DedupeKey + "|" + startKey(e)
+}
+
+// startKey formats an event's start for uidKey. Providers disagree on the
+// time of day of all-day events, so only their date counts.
+func startKey(e core.Event) string {
+	if e.IsAllDay {
+		return e.Start.UTC().Format("2006-01-02")
+	}
+	return e.Start.UTC().Truncate(time.Minute).Format(time.RFC3339)
+}
+
+// sameTimes reports whether two copies start and end at about the same
+// time: on the same days for all-day events, within timeTolerance otherwise.
+func sameTimes(a, b core.Event) bool {
+	if a.IsAllDay || b.IsAllDay {
+		return a.IsAllDay == b.IsAllDay &&
+			a.Start.UTC().Format("2006-01-02") == b.Start.UTC().Format("2006-01-02") &&
+			a.End.UTC().Format("2006-01-02") == b.End.UTC().Format("2006-01-02")
+	}
+	return within(a.Start, b.Start, timeTolerance) && within(a.End, b.End, timeTolerance)
+}
+
+func within(a, b time.Time, d time.Duration) bool {
+	diff := a.Sub(b)
+	return diff <= d && diff >= -d
+}
+
+// titleKey normalizes a title for comparison: lowercased, without
+// forwarding and invitation prefixes, punctuation, or repeated whitespace.
+func titleKey(title string) string {
+	t := strings.ToLower(strings.TrimSpace(title))
+	for {
+		trimmed := t
+		for _, prefix := range titlePrefixes {
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, prefix))
+		}
+		if trimmed == t {
+			break
+		}
+		t = trimmed
+	}
+
+	t = strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return ' '
+		}
+		return r
+	}, t)
+	return strings.Join(strings.Fields(t), " ")
+}
+
+// sameOrganizer matches organizers case-insensitively. An unknown
+// organizer matches anything, since not every provider reports it.
+func sameOrganizer(a, b core.Event) bool {
+	if a.Organizer == "" || b.Organizer == "" {
+		return true
+	}
+	return strings.EqualFold(a.Organizer, b.Organizer)
+}
+
+// calendarResponses returns the calendars an event appears in. Events the
+// adapter didn't deduplicate only have their own calendar.
+func calendarResponses(e core.Event) []core.CalendarResponse {
+	if len(e.Calendars) > 0 {
+		return e.Calendars
+	}
+	return []core.CalendarResponse{
+		{Calendar: e.Calendar, Status: e.Status, URL: e.URL},
+	}
+}
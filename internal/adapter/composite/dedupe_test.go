@@ -0,0 +1,167 @@
+package composite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// at parses "2006-01-02 15:04" in UTC.
+func at(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func event(account, uid, title, start string, d time.Duration) core.Event {
+	s := at(start)
+	return core.Event{
+		ID:         account + "-" + start,
+		DedupeKey:  uid,
+		ProviderID: account,
+		Calendar:   core.Calendar{ID: account + "/primary"},
+		Title:      title,
+		Start:      s,
+		End:        s.Add(d),
+	}
+}
+
+func TestMergeDuplicates(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []core.Event
+		// want is the number of calendars of each merged event
+		want []int
+	}{
+		{
+			name: "same uid and start in two accounts",
+			events: []core.Event{
+				event("work", "uid1", "Sync", "2026-03-04 10:00", time.Hour),
+				event("home", "uid1", "Sync", "2026-03-04 10:00", time.Hour),
+			},
+			want: []int{2},
+		},
+		{
+			name: "recurring instances share the uid",
+			events: []core.Event{
+				event("work", "uid1", "Standup", "2026-03-04 09:00", 15*time.Minute),
+				event("work", "uid1", "Standup", "2026-03-05 09:00", 15*time.Minute),
+				event("home", "uid1", "Standup", "2026-03-04 09:00", 15*time.Minute),
+				event("home", "uid1", "Standup", "2026-03-05 09:00", 15*time.Minute),
+			},
+			want: []int{2, 2},
+		},
+		{
+			name: "same uid in one account is left alone",
+			events: []core.Event{
+				event("work", "uid1", "Sync", "2026-03-04 10:00", time.Hour),
+				event("work", "uid1", "Sync", "2026-03-04 10:00", time.Hour),
+			},
+			want: []int{1, 1},
+		},
+		{
+			name: "forwarded copy without shared uid",
+			events: []core.Event{
+				event("work", "uid1", "Budget review", "2026-03-04 10:00", time.Hour),
+				event("home", "uid2", "FW: Budget review", "2026-03-04 10:00", time.Hour),
+			},
+			want: []int{2},
+		},
+		{
+			name: "invitation prefix and punctuation",
+			events: []core.Event{
+				event("work", "", "Q1 planning - kickoff!", "2026-03-04 10:00", time.Hour),
+				event("home", "", "Invitation: Q1 planning, kickoff", "2026-03-04 10:00", time.Hour),
+			},
+			want: []int{2},
+		},
+		{
+			name: "times a few minutes apart",
+			events: []core.Event{
+				event("work", "", "Sync", "2026-03-04 10:00", time.Hour),
+				event("home", "", "Sync", "2026-03-04 10:02", 58*time.Minute),
+			},
+			want: []int{2},
+		},
+		{
+			name: "times too far apart",
+			events: []core.Event{
+				event("work", "", "Sync", "2026-03-04 10:00", time.Hour),
+				event("home", "", "Sync", "2026-03-04 10:30", time.Hour),
+			},
+			want: []int{1, 1},
+		},
+		{
+			name: "different titles",
+			events: []core.Event{
+				event("work", "", "Sync", "2026-03-04 10:00", time.Hour),
+				event("home", "", "Dentist", "2026-03-04 10:00", time.Hour),
+			},
+			want: []int{1, 1},
+		},
+		{
+			name: "different organizers",
+			events: func() []core.Event {
+				a := event("work", "", "Sync", "2026-03-04 10:00", time.Hour)
+				a.Organizer = "alice@example.com"
+				b := event("home", "", "Sync", "2026-03-04 10:00", time.Hour)
+				b.Organizer = "bob@example.com"
+				return []core.Event{a, b}
+			}(),
+			want: []int{1, 1},
+		},
+		{
+			name: "all-day events on the same day",
+			events: func() []core.Event {
+				a := event("work", "", "Holiday", "2026-03-04 00:00", 24*time.Hour)
+				a.IsAllDay = true
+				b := event("home", "", "Holiday", "2026-03-04 05:00", 24*time.Hour)
+				b.IsAllDay = true
+				return []core.Event{a, b}
+			}(),
+			want: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := mergeDuplicates(tt.events)
+
+			var got []int
+			for _, e := range merged {
+				got = append(got, len(calendarResponses(e)))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("event %d: got %d calendars, want %d", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMergeDuplicatesKeepsInstanceStatus(t *testing.T) {
+	work := event("work", "uid1", "Standup", "2026-03-05 09:00", 15*time.Minute)
+	home1 := event("home", "uid1", "Standup", "2026-03-04 09:00", 15*time.Minute)
+	home1.Status = core.StatusAccepted
+	home2 := event("home", "uid1", "Standup", "2026-03-05 09:00", 15*time.Minute)
+	home2.Status = core.StatusRejected
+
+	merged := mergeDuplicates([]core.Event{work, home1, home2})
+	if len(merged) != 2 {
+		t.Fatalf("got %d events, want 2", len(merged))
+	}
+	calendars := merged[0].Calendars
+	if len(calendars) != 2 || calendars[1].Status != core.StatusRejected {
+		t.Errorf("Mar 5 instance got calendars %+v, want the home copy declined", calendars)
+	}
+	if !merged[1].Start.Equal(at("2026-03-04 09:00")) || len(merged[1].Calendars) != 0 {
+		t.Errorf("Mar 4 instance got start %v with calendars %+v, want it unmerged", merged[1].Start, merged[1].Calendars)
+	}
+}
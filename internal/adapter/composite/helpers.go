@@ -0,0 +1,55 @@
+package composite
+
+import (
+	"strings"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// calendarSep separates the account ID from the account's calendar ID.
+// Account IDs never contain it; calendar IDs might, so split on the first.
+const calendarSep = "/"
+
+func qualify(accountID, calendarID string) string {
+	return accountID + calendarSep + calendarID
+}
+
+func splitCalendarID(id string) (accountID, calendarID string, ok bool) {
+	return strings.Cut(id, calendarSep)
+}
+
+// accountOptions narrows opts.CalendarIDs to one account's calendars.
+// ok is false if calendars were selected but none belong to the account.
+func accountOptions(accountID string, opts core.FetchOptions) (core.FetchOptions, bool) {
+	if len(opts.CalendarIDs) == 0 {
+		return opts, true
+	}
+
+	var ids []string
+	for _, id := range opts.CalendarIDs {
+		if acc, calID, ok := splitCalendarID(id); ok && acc == accountID {
+			ids = append(ids, calID)
+		}
+	}
+	opts.CalendarIDs = ids
+	return opts, len(ids) > 0
+}
+
+// qualifyEvents tags an account's events with the account ID and prefixes
+// their calendar IDs.
+func qualifyEvents(accountID string, events []core.Event) []core.Event {
+	for i := range events {
+		events[i].ProviderID = accountID
+		events[i].Calendar.ID = qualify(accountID, events[i].Calendar.ID)
+
+		calendars := make([]core.CalendarResponse, len(events[i].Calendars))
+		for j, cr := range events[i].Calendars {
+			cr.Calendar.ID = qualify(accountID, cr.Calendar.ID)
+			calendars[j] = cr
+		}
+		if len(calendars) > 0 {
+			events[i].Calendars = calendars
+		}
+	}
+	return events
+}
@@ -0,0 +1,84 @@
+// Package eventutil holds the event list helpers shared by the adapters:
+// deduplication, ordering and filtering.
+package eventutil
+
+import (
+	"sort"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// Deduplicate merges events that share the same DedupeKey (ICalUID) and
+// start. The start matters because some providers give every instance of a
+// recurring series the series' ICalUID.
+// The first occurrence becomes the primary; subsequent occurrences add their
+// calendar and status to the Calendars slice.
+func Deduplicate(events []core.Event) []core.Event {
+	seen := make(map[string]int) // DedupeKey and start -> index in result
+	var result []core.Event
+
+	for _, event := range events {
+		if event.DedupeKey == "" {
+			// No dedup key — keep as-is
+			result = append(result, event)
+			continue
+		}
+
+		key := event.DedupeKey + "|" + event.Start.UTC().Format(time.RFC3339)
+		if idx, exists := seen[key]; exists {
+			// Duplicate — merge calendar info into the existing event
+			result[idx].Calendars = append(result[idx].Calendars, core.CalendarResponse{
+				Calendar: event.Calendar,
+				Status:   event.Status,
+				URL:      event.URL,
+			})
+		} else {
+			// First occurrence — initialize Calendars with this event's own info
+			event.Calendars = []core.CalendarResponse{
+				{Calendar: event.Calendar, Status: event.Status, URL: event.URL},
+			}
+			seen[key] = len(result)
+			result = append(result, event)
+		}
+	}
+
+	return result
+}
+
+// SortByStart sorts events by start time, keeping the order of events that
+// start at the same time.
+func SortByStart(events []core.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+}
+
+func ContainsType(types []core.EventType, t core.EventType) bool {
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func ContainsStatus(statuses []core.EventStatus, s core.EventStatus) bool {
+	for _, v := range statuses {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Matching returns the events that match query (see Event.MatchesQuery).
+func Matching(events []core.Event, query string) []core.Event {
+	var result []core.Event
+	for _, event := range events {
+		if event.MatchesQuery(query) {
+			result = append(result, event)
+		}
+	}
+	return result
+}
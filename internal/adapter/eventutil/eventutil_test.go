@@ -0,0 +1,37 @@
+package eventutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+func TestDeduplicate(t *testing.T) {
+	day := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+	instance := func(calendar string, start time.Time) core.Event {
+		return core.Event{
+			DedupeKey: "uid1",
+			Calendar:  core.Calendar{ID: calendar},
+			Start:     start,
+			End:       start.Add(15 * time.Minute),
+		}
+	}
+
+	// Two instances of a series, each in two calendars
+	events := Deduplicate([]core.Event{
+		instance("work", day),
+		instance("team", day),
+		instance("work", day.AddDate(0, 0, 1)),
+		instance("team", day.AddDate(0, 0, 1)),
+	})
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want one per instance", len(events))
+	}
+	for _, e := range events {
+		if len(e.Calendars) != 2 {
+			t.Errorf("instance on %s got %d calendars, want 2", e.Start.Format("Jan 2"), len(e.Calendars))
+		}
+	}
+}
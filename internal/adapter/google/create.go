@@ -0,0 +1,84 @@
+package google
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// CreateEvent inserts an event and invites its attendees. With
+// core.ConferenceGoogleMeet, Google attaches a new Meet link.
+func (g *GoogleAdapter) CreateEvent(ctx context.Context, calendarID string, draft core.EventDraft) (core.Event, error) {
+	if draft.Conference == core.ConferenceTeams {
+		return core.Event{}, fmt.Errorf("only Google Meet conferences can be created in Google Calendar")
+	}
+
+	item := &calendar.Event{
+		Summary:     draft.Title,
+		Description: draft.Description,
+		Location:    draft.Location,
+		Start:       eventDateTime(draft.Start, draft.IsAllDay),
+		End:         eventDateTime(draft.End, draft.IsAllDay),
+	}
+	for _, email := range draft.Attendees {
+		item.Attendees = append(item.Attendees, &calendar.EventAttendee{Email: email})
+	}
+
+	call := g.service.Events.Insert(calendarID, item).Context(ctx)
+	if len(draft.Attendees) > 0 {
+		call = call.SendUpdates("all")
+	}
+
+	if draft.Conference == core.ConferenceGoogleMeet {
+		requestID, err := newRequestID()
+		if err != nil {
+			return core.Event{}, err
+		}
+		item.ConferenceData = &calendar.ConferenceData{
+			CreateRequest: &calendar.CreateConferenceRequest{
+				RequestId:             requestID,
+				ConferenceSolutionKey: &calendar.ConferenceSolutionKey{Type: "hangoutsMeet"},
+			},
+		}
+		// Conference data is ignored unless the client declares support
+		call = call.ConferenceDataVersion(1)
+	}
+
+	created, err := call.Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to create event: %w", err)
+	}
+
+	return g.parseEvent(created, calendarID, g.calendars[calendarID]), nil
+}
+
+// eventDateTime converts a time to Google's format: a date for all-day
+// events, otherwise an RFC3339 time, with the zone name when it is known.
+func eventDateTime(t time.Time, isAllDay bool) *calendar.EventDateTime {
+	if isAllDay {
+		return &calendar.EventDateTime{Date: t.Format("2006-01-02")}
+	}
+	edt := &calendar.EventDateTime{DateTime: t.Format(time.RFC3339)}
+	if name := t.Location().String(); name != "Local" && name != "UTC" {
+		edt.TimeZone = name
+	}
+	return edt
+}
+
+// newRequestID returns a random ID for a conference create request.
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate request ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,81 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/theakshaypant/tsk/internal/core"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// DeleteEvent deletes an event the user organizes and sends attendees a
+// cancellation. With core.RecurringScopeAllInstances the whole series is
+// deleted; with core.RecurringScopeThisAndFollowing the series is ended
+// just before the instance, and the message is not sent.
+//
+// Google has no field for a cancellation note, so a message is put at the
+// top of the description just before the event is deleted; that is what
+// the cancellation email shows.
+func (g *GoogleAdapter) DeleteEvent(ctx context.Context, calendarID, eventID string, opts core.DeleteOptions) error {
+	item, err := g.service.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	if item.Status == "cancelled" {
+		return fmt.Errorf("event is already cancelled")
+	}
+	if item.Organizer == nil || !item.Organizer.Self {
+		return core.ErrNotOrganizer
+	}
+
+	if opts.Scope == core.RecurringScopeThisAndFollowing && item.RecurringEventId != "" {
+		return g.deleteFollowing(ctx, calendarID, item)
+	}
+
+	target := item
+	if opts.Scope == core.RecurringScopeAllInstances && item.RecurringEventId != "" {
+		target, err = g.service.Events.Get(calendarID, item.RecurringEventId).Context(ctx).Do()
+		if err != nil {
+			if isInsufficientScopeError(err) {
+				return core.ErrInsufficientScope
+			}
+			return fmt.Errorf("failed to fetch recurring event: %w", err)
+		}
+	}
+
+	notify := len(target.Attendees) > 0
+	if notify && opts.Message != "" {
+		description := opts.Message
+		if target.Description != "" {
+			description += "\n\n" + target.Description
+		}
+		_, err := g.service.Events.Patch(calendarID, target.Id, &calendar.Event{Description: description}).
+			SendUpdates("none").
+			Context(ctx).
+			Do()
+		if err != nil {
+			if isInsufficientScopeError(err) {
+				return core.ErrInsufficientScope
+			}
+			return fmt.Errorf("failed to add cancellation message: %w", err)
+		}
+	}
+
+	call := g.service.Events.Delete(calendarID, target.Id).Context(ctx)
+	if notify {
+		call = call.SendUpdates("all")
+	}
+	if err := call.Do(); err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	return nil
+}
@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
 	"github.com/theakshaypant/tsk/internal/core"
 
 	"google.golang.org/api/calendar/v3"
 )
 
 func (g *GoogleAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	return g.fetchEvents(ctx, opts, "")
+}
+
+// fetchEvents fetches the events matching opts from the selected calendars,
+// only those matching the free text query q when it isn't empty.
+func (g *GoogleAdapter) fetchEvents(ctx context.Context, opts core.FetchOptions, q string) ([]core.Event, error) {
 	var results []core.Event
 
 	// Determine which calendars to fetch from
@@ -23,28 +30,38 @@ func (g *GoogleAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions)
 	}
 
 	// Fetch from selected calendars
+	var lastErr error
+	fetched := 0
 	for _, calID := range calendarIDs {
 		// Skip if calendar doesn't exist
 		if _, exists := g.calendars[calID]; !exists {
 			continue
 		}
-		events, err := g.fetchEventsFromCalendar(ctx, calID, opts)
+		events, err := g.fetchEventsFromCalendar(ctx, calID, opts, q)
 		if err != nil {
 			// Log warning but continue with other calendars
+			lastErr = err
 			continue
 		}
+		fetched++
 		results = append(results, events...)
 	}
 
-	results = deduplicateEvents(results)
+	// Only fail when nothing could be fetched at all (e.g. network down),
+	// so callers can tell "no events" from "no data".
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	results = eventutil.Deduplicate(results)
 
 	// Sort by start time
-	sortEventsByStartTime(results)
+	eventutil.SortByStart(results)
 
 	return results, nil
 }
 
-func (g *GoogleAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID string, opts core.FetchOptions) ([]core.Event, error) {
+func (g *GoogleAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID string, opts core.FetchOptions, q string) ([]core.Event, error) {
 	// Google API requires RFC3339 format
 	tMin := opts.Start.Format(time.RFC3339)
 	tMax := opts.End.Format(time.RFC3339)
@@ -63,6 +80,9 @@ func (g *GoogleAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID
 			OrderBy("startTime").
 			Context(ctx)
 
+		if q != "" {
+			req = req.Q(q)
+		}
 		if pageToken != "" {
 			req = req.PageToken(pageToken)
 		}
@@ -78,17 +98,17 @@ func (g *GoogleAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID
 			// Treat timed events as all-day if they span the entire viewed day.
 			// These are multi-day spanning events that Google sends with
 			// DateTime instead of Date.
-			if !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+			if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
 				event.IsAllDay = true
 			}
 
 			// Filter by event type
-			if len(opts.IncludeTypes) > 0 && !containsType(opts.IncludeTypes, event.Type) {
+			if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
 				continue
 			}
 
 			// Filter by status
-			if len(opts.IncludeStatuses) > 0 && !containsStatus(opts.IncludeStatuses, event.Status) {
+			if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
 				continue
 			}
 
@@ -155,6 +175,12 @@ func (g *GoogleAdapter) parseEvent(item *calendar.Event, calendarID, calendarNam
 	// Extract meeting link from conference data
 	meetingLink := extractMeetingLink(item)
 
+	organizer, organizerName := "", ""
+	if item.Organizer != nil {
+		organizer = item.Organizer.Email
+		organizerName = item.Organizer.DisplayName
+	}
+
 	// Build unified Event
 	return core.Event{
 		ID:         item.Id,
@@ -164,18 +190,45 @@ func (g *GoogleAdapter) parseEvent(item *calendar.Event, calendarID, calendarNam
 			ID:   calendarID,
 			Name: calendarName,
 		},
-		Type:        eventType,
-		Title:       item.Summary,
-		Description: item.Description,
-		Location:    item.Location,
-		Status:      status,
-		URL:         item.HtmlLink,
-		MeetingLink: meetingLink,
-		Start:       startTime,
-		End:         endTime,
-		IsAllDay:    isAllDay,
-		Attachments: attachments,
+		Type:          eventType,
+		Title:         item.Summary,
+		Description:   item.Description,
+		Location:      item.Location,
+		Status:        status,
+		URL:           item.HtmlLink,
+		MeetingLink:   meetingLink,
+		Organizer:     organizer,
+		OrganizerName: organizerName,
+		Attendees:     parseAttendees(item.Attendees),
+		Start:         startTime,
+		End:           endTime,
+		IsAllDay:      isAllDay,
+		Attachments:   attachments,
+	}
+}
+
+// parseAttendees converts Google's guest list to core attendees.
+func parseAttendees(items []*calendar.EventAttendee) []core.Attendee {
+	var attendees []core.Attendee
+	for _, item := range items {
+		response := core.StatusAwaiting
+		switch item.ResponseStatus {
+		case "accepted":
+			response = core.StatusAccepted
+		case "declined":
+			response = core.StatusRejected
+		case "tentative":
+			response = core.StatusTentative
+		}
+		attendees = append(attendees, core.Attendee{
+			Name:       item.DisplayName,
+			Email:      item.Email,
+			Optional:   item.Optional,
+			Response:   response,
+			IsResource: item.Resource,
+		})
 	}
+	return attendees
 }
 
 // extractMeetingLink gets the video conferencing link from Google Calendar event.
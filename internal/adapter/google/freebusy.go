@@ -0,0 +1,59 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// FreeBusy looks up when each address is busy with a free/busy query.
+// Addresses Google reports an error for (unknown, or not shared with you)
+// are left out of the result.
+func (g *GoogleAdapter) FreeBusy(ctx context.Context, emails []string, start, end time.Time) (map[string][]core.BusyPeriod, error) {
+	req := &calendar.FreeBusyRequest{
+		TimeMin: start.Format(time.RFC3339),
+		TimeMax: end.Format(time.RFC3339),
+	}
+	for _, email := range emails {
+		req.Items = append(req.Items, &calendar.FreeBusyRequestItem{Id: email})
+	}
+
+	resp, err := g.service.Freebusy.Query(req).Context(ctx).Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return nil, core.ErrInsufficientScope
+		}
+		return nil, fmt.Errorf("failed to query free/busy: %w", err)
+	}
+
+	// Google may change the case of the addresses; key by what was asked for
+	requested := make(map[string]string, len(emails))
+	for _, email := range emails {
+		requested[strings.ToLower(email)] = email
+	}
+
+	busy := make(map[string][]core.BusyPeriod)
+	for id, cal := range resp.Calendars {
+		email, ok := requested[strings.ToLower(id)]
+		if !ok || len(cal.Errors) > 0 {
+			continue
+		}
+		periods := make([]core.BusyPeriod, 0, len(cal.Busy))
+		for _, b := range cal.Busy {
+			s, err1 := time.Parse(time.RFC3339, b.Start)
+			e, err2 := time.Parse(time.RFC3339, b.End)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			periods = append(periods, core.BusyPeriod{Start: s, End: e})
+		}
+		busy[email] = periods
+	}
+
+	return busy, nil
+}
@@ -25,6 +25,10 @@ func (g *GoogleAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		return fmt.Errorf("cannot respond to a cancelled event")
 	}
 
+	if opts.RecurringScope == core.RecurringScopeThisAndFollowing && event.RecurringEventId != "" {
+		return g.respondToFollowing(ctx, calendarID, event, opts)
+	}
+
 	// Determine which event ID to update based on recurring scope
 	// Otherwise use the instance ID (default: RecurringScopeThisInstance)
 	targetEventID := eventID
@@ -41,6 +45,11 @@ func (g *GoogleAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		}
 	}
 
+	return g.sendResponse(ctx, calendarID, targetEventID, event, opts)
+}
+
+// sendResponse sets the user's response on a fetched event and saves it.
+func (g *GoogleAdapter) sendResponse(ctx context.Context, calendarID, eventID string, event *calendar.Event, opts core.RespondOptions) error {
 	// Find the attendee representing the authenticated user
 	var userAttendee *calendar.EventAttendee
 	for i, attendee := range event.Attendees {
@@ -124,8 +133,8 @@ func (g *GoogleAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		userAttendee.Comment = comment
 	}
 
-	// Update the event (use targetEventID which may be the master recurring event)
-	_, err = g.service.Events.Update(calendarID, targetEventID, event).
+	// Update the event (eventID may be the master recurring event)
+	_, err := g.service.Events.Update(calendarID, eventID, event).
 		SendUpdates("all").
 		Context(ctx).
 		Do()
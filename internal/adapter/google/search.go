@@ -0,0 +1,14 @@
+package google
+
+import (
+	"context"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// SearchEvents searches the selected calendars with the free text query of
+// Events.List, which matches titles, descriptions, locations, attendees
+// and organizers.
+func (g *GoogleAdapter) SearchEvents(ctx context.Context, query string, opts core.FetchOptions) ([]core.Event, error) {
+	return g.fetchEvents(ctx, opts, query)
+}
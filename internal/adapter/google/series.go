@@ -0,0 +1,226 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// followingWindow bounds how far ahead "this and following" responses
+// reach for series without an end.
+const followingWindow = 365 * 24 * time.Hour
+
+// respondToFollowing responds to an instance and every later instance of
+// its series. Only organizers can split a series, so each instance gets
+// its own response.
+func (g *GoogleAdapter) respondToFollowing(ctx context.Context, calendarID string, item *calendar.Event, opts core.RespondOptions) error {
+	at := seriesTime(item.OriginalStartTime)
+	if at.IsZero() {
+		at = seriesTime(item.Start)
+	}
+
+	var instances []*calendar.Event
+	err := g.service.Events.Instances(calendarID, item.RecurringEventId).
+		TimeMin(at.Format(time.RFC3339)).
+		TimeMax(at.Add(followingWindow).Format(time.RFC3339)).
+		Pages(ctx, func(page *calendar.Events) error {
+			instances = append(instances, page.Items...)
+			return nil
+		})
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch recurring event instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		if instance.Status == "cancelled" {
+			continue
+		}
+		if err := g.sendResponse(ctx, calendarID, instance.Id, instance, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateFollowing changes an instance and every later instance of its
+// series: the series is ended just before the instance and a new series,
+// with the changes applied, carries on from there.
+func (g *GoogleAdapter) updateFollowing(ctx context.Context, calendarID string, item *calendar.Event, update core.EventUpdate) (core.Event, error) {
+	master, err := g.service.Events.Get(calendarID, item.RecurringEventId).Context(ctx).Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to fetch recurring event: %w", err)
+	}
+
+	dtstart, at := seriesTime(master.Start), seriesTime(item.OriginalStartTime)
+	if !at.After(dtstart) {
+		// Splitting at the first instance changes the whole series
+		update.Scope = core.RecurringScopeAllInstances
+		return g.UpdateEvent(ctx, calendarID, item.Id, update)
+	}
+
+	isAllDay := master.Start.Date != ""
+	before, after, err := recurrence.SplitRules(master.Recurrence, dtstart, isAllDay, at)
+	if err != nil {
+		return core.Event{}, err
+	}
+
+	// The new series starts where this instance is now, or where it moves to
+	instance := g.parseEvent(item, calendarID, "")
+	start, end, newAllDay := instance.Start, instance.End, instance.IsAllDay
+	if update.Reschedules() {
+		start, end, newAllDay = update.Start, update.End, update.IsAllDay
+	}
+
+	next := &calendar.Event{
+		Summary:        master.Summary,
+		Description:    master.Description,
+		Location:       master.Location,
+		ColorId:        master.ColorId,
+		Transparency:   master.Transparency,
+		Visibility:     master.Visibility,
+		Reminders:      master.Reminders,
+		ConferenceData: master.ConferenceData,
+		Start:          seriesDateTime(start, newAllDay, master.Start),
+		End:            seriesDateTime(end, newAllDay, master.End),
+		Recurrence:     after,
+	}
+	for _, attendee := range master.Attendees {
+		next.Attendees = append(next.Attendees, &calendar.EventAttendee{
+			Email:       attendee.Email,
+			DisplayName: attendee.DisplayName,
+			Optional:    attendee.Optional,
+			Resource:    attendee.Resource,
+		})
+	}
+	if next.ConferenceData != nil {
+		// Reuse the existing call rather than asking for a new one
+		next.ConferenceData.CreateRequest = nil
+	}
+	if update.Title != nil {
+		next.Summary = *update.Title
+	}
+	if update.Description != nil {
+		next.Description = *update.Description
+	}
+	if update.Location != nil {
+		next.Location = *update.Location
+	}
+	if len(update.AddAttendees) > 0 || len(update.RemoveAttendees) > 0 {
+		next.Attendees = mergeAttendees(next.Attendees, update.AddAttendees, update.RemoveAttendees)
+	}
+
+	// Create the new series before ending the old one: a failure then
+	// leaves a duplicate behind rather than losing the later instances
+	call := g.service.Events.Insert(calendarID, next).Context(ctx)
+	if len(next.Attendees) > 0 {
+		call = call.SendUpdates("all")
+	}
+	if next.ConferenceData != nil {
+		call = call.ConferenceDataVersion(1)
+	}
+	created, err := call.Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to create the new series: %w", err)
+	}
+
+	if err := g.endSeries(ctx, calendarID, master, before); err != nil {
+		return core.Event{}, err
+	}
+
+	return g.parseEvent(created, calendarID, g.calendars[calendarID]), nil
+}
+
+// deleteFollowing deletes an instance and every later instance of its
+// series by ending the series just before it.
+func (g *GoogleAdapter) deleteFollowing(ctx context.Context, calendarID string, item *calendar.Event) error {
+	master, err := g.service.Events.Get(calendarID, item.RecurringEventId).Context(ctx).Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch recurring event: %w", err)
+	}
+
+	dtstart, at := seriesTime(master.Start), seriesTime(item.OriginalStartTime)
+	if !at.After(dtstart) {
+		// Nothing is left before the first instance
+		call := g.service.Events.Delete(calendarID, master.Id).Context(ctx)
+		if len(master.Attendees) > 0 {
+			call = call.SendUpdates("all")
+		}
+		if err := call.Do(); err != nil {
+			if isInsufficientScopeError(err) {
+				return core.ErrInsufficientScope
+			}
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
+		return nil
+	}
+
+	before, _, err := recurrence.SplitRules(master.Recurrence, dtstart, master.Start.Date != "", at)
+	if err != nil {
+		return err
+	}
+	return g.endSeries(ctx, calendarID, master, before)
+}
+
+// endSeries replaces the rules of a series with ones that end it earlier.
+func (g *GoogleAdapter) endSeries(ctx context.Context, calendarID string, master *calendar.Event, rules []string) error {
+	call := g.service.Events.Patch(calendarID, master.Id, &calendar.Event{Recurrence: rules}).Context(ctx)
+	if len(master.Attendees) > 0 {
+		call = call.SendUpdates("all")
+	}
+	if _, err := call.Do(); err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to end the recurring series: %w", err)
+	}
+	return nil
+}
+
+// seriesTime reads a start time in the zone its series repeats in, which
+// is what the rules are evaluated against. All-day dates are UTC midnight.
+func seriesTime(edt *calendar.EventDateTime) time.Time {
+	if edt == nil {
+		return time.Time{}
+	}
+	if edt.DateTime == "" {
+		t, _ := time.Parse("2006-01-02", edt.Date)
+		return t
+	}
+	t, err := time.Parse(time.RFC3339, edt.DateTime)
+	if err != nil {
+		return time.Time{}
+	}
+	if edt.TimeZone != "" {
+		if loc, err := time.LoadLocation(edt.TimeZone); err == nil {
+			t = t.In(loc)
+		}
+	}
+	return t
+}
+
+// seriesDateTime is eventDateTime for a recurring event, which needs the
+// zone its rules are expanded in.
+func seriesDateTime(t time.Time, isAllDay bool, current *calendar.EventDateTime) *calendar.EventDateTime {
+	edt := eventDateTime(t, isAllDay)
+	if !isAllDay && current != nil && current.TimeZone != "" {
+		edt.TimeZone = current.TimeZone
+	}
+	return edt
+}
@@ -0,0 +1,71 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+
+	"google.golang.org/api/googleapi"
+)
+
+// SyncChanges returns what changed in a calendar since the sync that produced
+// token (a Google nextSyncToken). An empty token starts a full sync of
+// [start, end) whose final page yields the first sync token.
+func (g *GoogleAdapter) SyncChanges(ctx context.Context, calendarID string, start, end time.Time, token string) (*core.ChangeSet, error) {
+	calendarName := g.calendars[calendarID]
+	changes := &core.ChangeSet{Full: token == ""}
+	pageToken := ""
+
+	for {
+		req := g.service.Events.List(calendarID).
+			SingleEvents(true).
+			Context(ctx)
+
+		// The time window can only be set on the initial request; requests
+		// with a sync token report changes anywhere in the calendar.
+		if token == "" {
+			req = req.TimeMin(start.Format(time.RFC3339)).TimeMax(end.Format(time.RFC3339))
+		} else {
+			req = req.SyncToken(token)
+		}
+
+		if pageToken != "" {
+			req = req.PageToken(pageToken)
+		}
+
+		eventsResult, err := req.Do()
+		if err != nil {
+			if isGoneError(err) {
+				return nil, core.ErrSyncTokenExpired
+			}
+			return nil, fmt.Errorf("sync failed for calendar %s: %w", calendarID, err)
+		}
+
+		for _, item := range eventsResult.Items {
+			// Deletions come back as cancelled events with only the ID set
+			if item.Status == "cancelled" {
+				changes.Deleted = append(changes.Deleted, item.Id)
+				continue
+			}
+			changes.Events = append(changes.Events, g.parseEvent(item, calendarID, calendarName))
+		}
+
+		pageToken = eventsResult.NextPageToken
+		if pageToken == "" {
+			changes.Token = eventsResult.NextSyncToken
+			break
+		}
+	}
+
+	return changes, nil
+}
+
+// isGoneError checks if Google rejected a sync token as expired (410 Gone).
+func isGoneError(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusGone
+}
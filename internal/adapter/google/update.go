@@ -0,0 +1,139 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// UpdateEvent patches an event the user organizes and notifies its
+// attendees. With core.RecurringScopeAllInstances the series master is
+// patched instead, so every instance changes; with
+// core.RecurringScopeThisAndFollowing the series is split at the instance.
+func (g *GoogleAdapter) UpdateEvent(ctx context.Context, calendarID, eventID string, update core.EventUpdate) (core.Event, error) {
+	item, err := g.service.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	if item.Status == "cancelled" {
+		return core.Event{}, fmt.Errorf("cannot change a cancelled event")
+	}
+	if item.Organizer == nil || !item.Organizer.Self {
+		return core.Event{}, core.ErrNotOrganizer
+	}
+
+	if update.Scope == core.RecurringScopeThisAndFollowing && item.RecurringEventId != "" {
+		return g.updateFollowing(ctx, calendarID, item, update)
+	}
+
+	target := item
+	start, end := update.Start, update.End
+	if update.Scope == core.RecurringScopeAllInstances && item.RecurringEventId != "" {
+		master, err := g.service.Events.Get(calendarID, item.RecurringEventId).Context(ctx).Do()
+		if err != nil {
+			if isInsufficientScopeError(err) {
+				return core.Event{}, core.ErrInsufficientScope
+			}
+			return core.Event{}, fmt.Errorf("failed to fetch recurring event: %w", err)
+		}
+		if update.Reschedules() {
+			// Move the series by as much as this instance moves
+			start, end = recurrence.MoveSeries(
+				g.parseEvent(master, calendarID, ""),
+				g.parseEvent(item, calendarID, ""),
+				update.Start, update.End, update.IsAllDay)
+		}
+		target = master
+	}
+
+	patch := &calendar.Event{}
+	// Empty strings are dropped from the request unless forced
+	if update.Title != nil {
+		patch.Summary = *update.Title
+		patch.ForceSendFields = append(patch.ForceSendFields, "Summary")
+	}
+	if update.Description != nil {
+		patch.Description = *update.Description
+		patch.ForceSendFields = append(patch.ForceSendFields, "Description")
+	}
+	if update.Location != nil {
+		patch.Location = *update.Location
+		patch.ForceSendFields = append(patch.ForceSendFields, "Location")
+	}
+	if update.Reschedules() {
+		patch.Start = patchDateTime(start, update.IsAllDay, target.Start)
+		patch.End = patchDateTime(end, update.IsAllDay, target.End)
+	}
+	if len(update.AddAttendees) > 0 || len(update.RemoveAttendees) > 0 {
+		patch.Attendees = mergeAttendees(target.Attendees, update.AddAttendees, update.RemoveAttendees)
+		patch.ForceSendFields = append(patch.ForceSendFields, "Attendees")
+	}
+
+	call := g.service.Events.Patch(calendarID, target.Id, patch).Context(ctx)
+	if len(target.Attendees) > 0 || len(patch.Attendees) > 0 {
+		call = call.SendUpdates("all")
+	}
+
+	updated, err := call.Do()
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to update event: %w", err)
+	}
+
+	return g.parseEvent(updated, calendarID, g.calendars[calendarID]), nil
+}
+
+// patchDateTime is eventDateTime for a patch: it clears the field of the
+// other kind (date vs time) and keeps the event's time zone, which Google
+// needs to expand recurring events.
+func patchDateTime(t time.Time, isAllDay bool, current *calendar.EventDateTime) *calendar.EventDateTime {
+	edt := eventDateTime(t, isAllDay)
+	if isAllDay {
+		edt.NullFields = []string{"DateTime", "TimeZone"}
+		return edt
+	}
+	edt.NullFields = []string{"Date"}
+	if current != nil && current.TimeZone != "" {
+		edt.TimeZone = current.TimeZone
+	}
+	return edt
+}
+
+// mergeAttendees applies invitations and removals to a guest list, keeping
+// the existing entries (and their responses) as they are.
+func mergeAttendees(current []*calendar.EventAttendee, add, remove []string) []*calendar.EventAttendee {
+	removed := make(map[string]bool, len(remove))
+	for _, email := range remove {
+		removed[strings.ToLower(email)] = true
+	}
+
+	result := []*calendar.EventAttendee{}
+	present := make(map[string]bool)
+	for _, attendee := range current {
+		email := strings.ToLower(attendee.Email)
+		if removed[email] {
+			continue
+		}
+		present[email] = true
+		result = append(result, attendee)
+	}
+	for _, email := range add {
+		if key := strings.ToLower(email); !present[key] && !removed[key] {
+			present[key] = true
+			result = append(result, &calendar.EventAttendee{Email: email})
+		}
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package ics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ICSAdapter implements the calendar provider for local iCalendar files:
+// a single .ics file, or a directory in the vdir layout used by
+// vdirsyncer, where each subdirectory is a calendar holding one .ics file
+// per event.
+type ICSAdapter struct {
+	id        string
+	name      string
+	path      string
+	email     string
+	calendars map[string]string
+	// sources maps calendar IDs to the file or directory they are read from
+	sources map[string]string
+}
+
+// NewICSAdapter creates an adapter reading from path. email identifies the
+// user among event attendees.
+func NewICSAdapter(id, name, path, email string) *ICSAdapter {
+	return &ICSAdapter{
+		id:        id,
+		name:      name,
+		path:      path,
+		email:     email,
+		calendars: make(map[string]string),
+		sources:   make(map[string]string),
+	}
+}
+
+func (a *ICSAdapter) ID() string   { return a.id }
+func (a *ICSAdapter) Name() string { return a.name }
+
+// Login finds the calendars under the configured path. Events are read
+// on every fetch, so changes to the files show up right away.
+func (a *ICSAdapter) Login(ctx context.Context) error {
+	if err := a.loadCalendarList(); err != nil {
+		return fmt.Errorf("load calendar list: %w", err)
+	}
+	return nil
+}
+
+// Calendars returns all available calendars (ID → Name).
+func (a *ICSAdapter) Calendars() map[string]string {
+	return a.calendars
+}
+
+// loadCalendarList maps the path to calendars:
+//   - a file is one calendar
+//   - a directory with .ics files in it is one calendar (a vdir collection)
+//   - each subdirectory of a directory is a calendar (a vdir storage)
+func (a *ICSAdapter) loadCalendarList() error {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		id := strings.TrimSuffix(filepath.Base(a.path), filepath.Ext(a.path))
+		a.addCalendar(id, a.path, calendarName(a.path))
+		return nil
+	}
+
+	entries, err := os.ReadDir(a.path)
+	if err != nil {
+		return err
+	}
+
+	hasFiles := false
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if entry.IsDir() {
+			dir := filepath.Join(a.path, entry.Name())
+			a.addCalendar(entry.Name(), dir, collectionName(dir))
+		} else if isICSFile(entry.Name()) {
+			hasFiles = true
+		}
+	}
+
+	if hasFiles {
+		a.addCalendar(filepath.Base(a.path), a.path, collectionName(a.path))
+	}
+
+	if len(a.calendars) == 0 {
+		return fmt.Errorf("no .ics files or calendar directories in %s", a.path)
+	}
+	return nil
+}
+
+func (a *ICSAdapter) addCalendar(id, source, name string) {
+	if name == "" {
+		name = id
+	}
+	a.calendars[id] = name
+	a.sources[id] = source
+}
+
+// self returns the addresses that identify the user in attendee lists.
+func (a *ICSAdapter) self() []string {
+	if a.email == "" {
+		return nil
+	}
+	return []string{a.email}
+}
+
+// collectionName reads a vdir collection's displayname file.
+func collectionName(dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "displayname"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// calendarName reads the X-WR-CALNAME of a calendar file.
+func calendarName(file string) string {
+	cals, err := readFile(file)
+	if err != nil {
+		return ""
+	}
+	for _, cal := range cals {
+		if name, err := cal.Props.Text("X-WR-CALNAME"); err == nil && name != "" {
+			return name
+		}
+	}
+	return ""
+}
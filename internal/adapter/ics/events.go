@@ -0,0 +1,240 @@
+package ics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+	"github.com/theakshaypant/tsk/internal/icalendar"
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// FetchEvents reads the events of the selected calendars matching the given options.
+func (a *ICSAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	var results []core.Event
+
+	calendarIDs := opts.CalendarIDs
+	if len(calendarIDs) == 0 {
+		for calID := range a.calendars {
+			calendarIDs = append(calendarIDs, calID)
+		}
+	}
+
+	var lastErr error
+	fetched := 0
+	for _, calID := range calendarIDs {
+		if _, exists := a.calendars[calID]; !exists {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		events, err := a.fetchEventsFromCalendar(calID, opts)
+		if err != nil {
+			lastErr = err
+			continue // skip failed calendars
+		}
+		fetched++
+		results = append(results, events...)
+	}
+
+	// Every calendar failed — report it instead of returning an empty list
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	results = eventutil.Deduplicate(results)
+	eventutil.SortByStart(results)
+
+	return results, nil
+}
+
+func (a *ICSAdapter) fetchEventsFromCalendar(calendarID string, opts core.FetchOptions) ([]core.Event, error) {
+	files, err := calendarFiles(a.sources[calendarID])
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := core.Calendar{ID: calendarID, Name: a.calendars[calendarID]}
+	var results []core.Event
+
+	var lastErr error
+	read := 0
+	for _, file := range files {
+		cals, err := readFile(file)
+		if err != nil {
+			lastErr = fmt.Errorf("read %s: %w", file, err)
+			continue // skip unreadable items
+		}
+		read++
+
+		for _, cal := range cals {
+			for _, event := range icalendar.Events(cal, a.self(), opts) {
+				event.ProviderID = a.ID()
+				event.Calendar = calendar
+
+				if !event.Start.Before(opts.End) || (!event.End.After(opts.Start) && !event.Start.Equal(opts.Start)) {
+					continue
+				}
+
+				// Treat timed events as all-day if they span the entire viewed day
+				if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+					event.IsAllDay = true
+				}
+
+				if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
+					continue
+				}
+				if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
+					continue
+				}
+				if opts.ExcludeAllDay && event.IsAllDay {
+					continue
+				}
+
+				results = append(results, event)
+			}
+		}
+	}
+
+	if read == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return results, nil
+}
+
+// RespondToEvent records the user's response (PARTSTAT) in the file holding
+// the event. Nobody is notified; a sync tool like vdirsyncer uploads the
+// change on its next run.
+func (a *ICSAdapter) RespondToEvent(ctx context.Context, calendarID, eventID string, opts core.RespondOptions) error {
+	if opts.ProposedTime != nil {
+		return fmt.Errorf("proposing a new time is not supported for .ics calendars")
+	}
+	if opts.Comment != "" {
+		return fmt.Errorf("comments are not supported for .ics calendars")
+	}
+	source, exists := a.sources[calendarID]
+	if !exists {
+		return fmt.Errorf("unknown calendar %s", calendarID)
+	}
+
+	uid, _ := recurrence.SplitID(eventID)
+	file, cals, err := findEvent(source, uid)
+	if err != nil {
+		return fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	for _, cal := range cals {
+		if icalendar.Master(cal, uid) == nil && len(icalendar.Overrides(cal, uid)) == 0 {
+			continue
+		}
+		if err := icalendar.Respond(cal, eventID, a.self(), opts.Response, opts.RecurringScope); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFile(file, cals); err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	return nil
+}
+
+// findEvent returns the file holding the event with the given UID.
+func findEvent(source, uid string) (string, []*ical.Calendar, error) {
+	files, err := calendarFiles(source)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, file := range files {
+		cals, err := readFile(file)
+		if err != nil {
+			continue
+		}
+		for _, cal := range cals {
+			if icalendar.Master(cal, uid) != nil || len(icalendar.Overrides(cal, uid)) > 0 {
+				return file, cals, nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("event %s not found", uid)
+}
+
+// calendarFiles lists the .ics files of a calendar source.
+func calendarFiles(source string) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{source}, nil
+	}
+
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && isICSFile(entry.Name()) && !strings.HasPrefix(entry.Name(), ".") {
+			files = append(files, filepath.Join(source, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// readFile decodes every VCALENDAR in a file.
+func readFile(file string) ([]*ical.Calendar, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cals []*ical.Calendar
+	dec := ical.NewDecoder(f)
+	for {
+		cal, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		cals = append(cals, cal)
+	}
+	return cals, nil
+}
+
+// writeFile replaces a calendar file, keeping its permissions.
+func writeFile(file string, cals []*ical.Calendar) error {
+	var buf bytes.Buffer
+	enc := ical.NewEncoder(&buf)
+	for _, cal := range cals {
+		if err := enc.Encode(cal); err != nil {
+			return err
+		}
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	return util.WriteFileAtomic(file, buf.Bytes(), info.Mode().Perm())
+}
+
+func isICSFile(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".ics")
+}
@@ -0,0 +1,200 @@
+package icsurl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshInterval is how old a feed's copy may get before it is
+// checked for changes again.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// ICSURLAdapter implements the calendar provider for subscribed iCalendar
+// feeds (webcal:// or https:// URLs). Each feed is one calendar. Feeds are
+// fetched with conditional requests (ETag / If-Modified-Since) and a copy
+// is kept on disk, which is served when the feed can't be reached.
+type ICSURLAdapter struct {
+	id              string
+	name            string
+	urls            []string
+	email           string
+	cacheDir        string
+	refreshInterval time.Duration
+	http            *http.Client
+	calendars       map[string]string
+	// feeds maps calendar IDs to their feed
+	feeds map[string]*feed
+	// loadMu serializes feed loads, which update the feeds in place
+	loadMu sync.Mutex
+
+	mu      sync.Mutex
+	offline bool
+	// unreachable holds the feeds whose last fetch failed to connect
+	unreachable map[string]bool
+}
+
+// NewICSURLAdapter creates an adapter for the given feed URLs. Copies of
+// the feeds are kept in cacheDir; an empty cacheDir keeps them in memory
+// only. email identifies the user among event attendees.
+func NewICSURLAdapter(id, name string, urls []string, email, cacheDir string, refreshInterval time.Duration) *ICSURLAdapter {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &ICSURLAdapter{
+		id:              id,
+		name:            name,
+		urls:            urls,
+		email:           email,
+		cacheDir:        cacheDir,
+		refreshInterval: refreshInterval,
+		http:            &http.Client{Timeout: 30 * time.Second},
+		calendars:       make(map[string]string),
+		feeds:           make(map[string]*feed),
+		unreachable:     make(map[string]bool),
+	}
+}
+
+func (a *ICSURLAdapter) ID() string   { return a.id }
+func (a *ICSURLAdapter) Name() string { return a.name }
+
+// SetOffline switches between fetching feeds and serving the stored copies only.
+func (a *ICSURLAdapter) SetOffline(offline bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.offline = offline
+}
+
+// Offline reports whether stored copies are being served, either because
+// offline mode was requested or a feed was unreachable.
+func (a *ICSURLAdapter) Offline() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.offline || len(a.unreachable) > 0
+}
+
+// offlineRequested reports whether offline mode was requested, as opposed
+// to a feed being unreachable right now.
+func (a *ICSURLAdapter) offlineRequested() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.offline
+}
+
+// setReachable records whether a feed could be reached on its last fetch.
+func (a *ICSURLAdapter) setReachable(feedURL string, reachable bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if reachable {
+		delete(a.unreachable, feedURL)
+	} else {
+		a.unreachable[feedURL] = true
+	}
+}
+
+// SyncedAt returns when the oldest feed was last fetched. ok is false if
+// no feed was ever fetched.
+func (a *ICSURLAdapter) SyncedAt(start, end time.Time) (syncedAt time.Time, ok bool) {
+	a.loadMu.Lock()
+	defer a.loadMu.Unlock()
+
+	for _, f := range a.feeds {
+		if f.fetchedAt.IsZero() {
+			continue
+		}
+		if !ok || f.fetchedAt.Before(syncedAt) {
+			syncedAt = f.fetchedAt
+			ok = true
+		}
+	}
+	return syncedAt, ok
+}
+
+// Login fetches every feed to learn its name. Feeds that fail are left
+// out; Login only fails if none could be loaded.
+func (a *ICSURLAdapter) Login(ctx context.Context) error {
+	a.loadMu.Lock()
+	defer a.loadMu.Unlock()
+
+	var lastErr error
+	for _, rawURL := range a.urls {
+		feedURL, err := normalizeURL(rawURL)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		f := a.feeds[feedURL]
+		if f == nil {
+			f = &feed{url: feedURL}
+		}
+		if err := a.load(ctx, f); err != nil {
+			lastErr = fmt.Errorf("load %s: %w", rawURL, err)
+			continue // skip failed feeds
+		}
+
+		a.feeds[feedURL] = f
+		a.calendars[feedURL] = f.calendarName()
+	}
+
+	if len(a.calendars) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no feed URLs configured")
+		}
+		return lastErr
+	}
+	return nil
+}
+
+// Calendars returns all available calendars (ID → Name).
+func (a *ICSURLAdapter) Calendars() map[string]string {
+	return a.calendars
+}
+
+// self returns the addresses that identify the user in attendee lists.
+func (a *ICSURLAdapter) self() []string {
+	if a.email == "" {
+		return nil
+	}
+	return []string{a.email}
+}
+
+// normalizeURL turns webcal:// URLs into the https:// URLs they stand for.
+// The result is the feed's calendar ID.
+func normalizeURL(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid feed URL %q: %w", rawURL, err)
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "webcal", "webcals", "https":
+		u.Scheme = "https"
+	case "http":
+	default:
+		return "", fmt.Errorf("invalid feed URL %q: use webcal://, https:// or http://", rawURL)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid feed URL %q: no host", rawURL)
+	}
+	return u.String(), nil
+}
+
+// fallbackName names a feed without an X-WR-CALNAME after its file name,
+// or its host.
+func fallbackName(feedURL string) string {
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return feedURL
+	}
+	base := strings.TrimSuffix(path.Base(u.Path), path.Ext(u.Path))
+	if base == "" || base == "." || base == "/" {
+		return u.Host
+	}
+	return base
+}
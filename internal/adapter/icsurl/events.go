@@ -0,0 +1,92 @@
+package icsurl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/icalendar"
+)
+
+// FetchEvents returns the events of the selected feeds matching the given
+// options. Feeds older than the refresh interval are revalidated first.
+func (a *ICSURLAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions) ([]core.Event, error) {
+	var results []core.Event
+
+	calendarIDs := opts.CalendarIDs
+	if len(calendarIDs) == 0 {
+		for calID := range a.calendars {
+			calendarIDs = append(calendarIDs, calID)
+		}
+	}
+
+	a.loadMu.Lock()
+	defer a.loadMu.Unlock()
+
+	var lastErr error
+	fetched := 0
+	for _, calID := range calendarIDs {
+		f, exists := a.feeds[calID]
+		if !exists {
+			continue
+		}
+		if err := a.load(ctx, f); err != nil {
+			lastErr = fmt.Errorf("load %s: %w", a.calendars[calID], err)
+			continue // skip failed feeds
+		}
+		fetched++
+		results = append(results, a.eventsFromFeed(calID, f, opts)...)
+	}
+
+	// Every feed failed — report it instead of returning an empty list
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	results = eventutil.Deduplicate(results)
+	eventutil.SortByStart(results)
+
+	return results, nil
+}
+
+func (a *ICSURLAdapter) eventsFromFeed(calendarID string, f *feed, opts core.FetchOptions) []core.Event {
+	calendar := core.Calendar{ID: calendarID, Name: a.calendars[calendarID]}
+	var results []core.Event
+
+	for _, cal := range f.cals {
+		for _, event := range icalendar.Events(cal, a.self(), opts) {
+			event.ProviderID = a.ID()
+			event.Calendar = calendar
+
+			if !event.Start.Before(opts.End) || (!event.End.After(opts.Start) && !event.Start.Equal(opts.Start)) {
+				continue
+			}
+
+			// Treat timed events as all-day if they span the entire viewed day
+			if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+				event.IsAllDay = true
+			}
+
+			if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
+				continue
+			}
+			if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
+				continue
+			}
+			if opts.ExcludeAllDay && event.IsAllDay {
+				continue
+			}
+
+			results = append(results, event)
+		}
+	}
+
+	return results
+}
+
+// RespondToEvent always fails: feeds are published read-only, and the
+// organizer would never see a response.
+func (a *ICSURLAdapter) RespondToEvent(ctx context.Context, calendarID, eventID string, opts core.RespondOptions) error {
+	return fmt.Errorf("cannot respond to events in subscribed calendars, they are read-only")
+}
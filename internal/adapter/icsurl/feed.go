@@ -0,0 +1,226 @@
+package icsurl
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// maxFeedSize bounds how much of a feed is read.
+const maxFeedSize = 32 << 20
+
+// feed is one subscribed calendar and its last fetched copy.
+type feed struct {
+	url  string
+	cals []*ical.Calendar
+
+	// HTTP validators and fetch time of the copy
+	etag         string
+	lastModified string
+	fetchedAt    time.Time
+}
+
+// feedMeta is stored next to a feed's copy on disk.
+type feedMeta struct {
+	URL          string
+	ETag         string
+	LastModified string
+	FetchedAt    time.Time
+}
+
+// calendarName returns the feed's X-WR-CALNAME, or a name derived from its URL.
+func (f *feed) calendarName() string {
+	for _, cal := range f.cals {
+		if name, err := cal.Props.Text("X-WR-CALNAME"); err == nil && name != "" {
+			return name
+		}
+	}
+	return fallbackName(f.url)
+}
+
+// load brings a feed up to date. A copy younger than the refresh interval
+// is used as is; an older one is revalidated with the server. When the
+// server can't be reached, the stored copy is served and the adapter
+// reports offline until the feed can be fetched again.
+func (a *ICSURLAdapter) load(ctx context.Context, f *feed) error {
+	if f.cals == nil {
+		if err := a.readCopy(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if a.offlineRequested() {
+		if f.cals == nil {
+			return fmt.Errorf("feed not available offline, it was never fetched")
+		}
+		return nil
+	}
+	if f.cals != nil && time.Since(f.fetchedAt) < a.refreshInterval {
+		return nil
+	}
+
+	err := a.fetch(ctx, f)
+	if err != nil && util.IsNetworkError(err) && f.cals != nil {
+		// The stored copy is older than the refresh interval, so the
+		// next load tries the server again
+		a.setReachable(f.url, false)
+		return nil
+	}
+	if err == nil {
+		a.setReachable(f.url, true)
+	}
+	return err
+}
+
+// fetch downloads a feed, sending the validators of the current copy so an
+// unchanged feed costs a 304 response only.
+func (a *ICSURLAdapter) fetch(ctx context.Context, f *feed) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/calendar, */*;q=0.5")
+	if f.cals != nil {
+		if f.etag != "" {
+			req.Header.Set("If-None-Match", f.etag)
+		}
+		if f.lastModified != "" {
+			req.Header.Set("If-Modified-Since", f.lastModified)
+		}
+	}
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && f.cals != nil:
+		f.fetchedAt = time.Now()
+		return a.writeMeta(f)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxFeedSize {
+		return fmt.Errorf("feed is larger than %d MB", maxFeedSize>>20)
+	}
+
+	cals, err := decode(data)
+	if err != nil {
+		return fmt.Errorf("invalid iCalendar data: %w", err)
+	}
+
+	f.cals = cals
+	f.etag = resp.Header.Get("ETag")
+	f.lastModified = resp.Header.Get("Last-Modified")
+	f.fetchedAt = time.Now()
+
+	if a.cacheDir == "" {
+		return nil
+	}
+	if err := util.WriteFileAtomic(a.copyPath(f)+".ics", data, 0600); err != nil {
+		return fmt.Errorf("store feed: %w", err)
+	}
+	return a.writeMeta(f)
+}
+
+// readCopy loads the stored copy of a feed.
+func (a *ICSURLAdapter) readCopy(f *feed) error {
+	if a.cacheDir == "" {
+		return os.ErrNotExist
+	}
+
+	metaData, err := os.ReadFile(a.copyPath(f) + ".json")
+	if err != nil {
+		return err
+	}
+	var meta feedMeta
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return fmt.Errorf("decode feed state: %w", err)
+	}
+
+	data, err := os.ReadFile(a.copyPath(f) + ".ics")
+	if err != nil {
+		return err
+	}
+	cals, err := decode(data)
+	if err != nil {
+		// A damaged copy is fetched again
+		return os.ErrNotExist
+	}
+
+	f.cals = cals
+	f.etag = meta.ETag
+	f.lastModified = meta.LastModified
+	f.fetchedAt = meta.FetchedAt
+	return nil
+}
+
+func (a *ICSURLAdapter) writeMeta(f *feed) error {
+	if a.cacheDir == "" {
+		return nil
+	}
+	data, err := json.Marshal(feedMeta{
+		URL:          f.url,
+		ETag:         f.etag,
+		LastModified: f.lastModified,
+		FetchedAt:    f.fetchedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if err := util.WriteFileAtomic(a.copyPath(f)+".json", data, 0600); err != nil {
+		return fmt.Errorf("store feed state: %w", err)
+	}
+	return nil
+}
+
+// copyPath returns where a feed's copy is stored, without extension:
+//
+//	<cacheDir>/feeds/<providerID>/<hash of URL>
+//
+// URLs can be long and hold tokens, so they aren't used as file names.
+func (a *ICSURLAdapter) copyPath(f *feed) string {
+	sum := sha256.Sum256([]byte(f.url))
+	return filepath.Join(a.cacheDir, "feeds", url.PathEscape(a.id), hex.EncodeToString(sum[:16]))
+}
+
+// decode parses every VCALENDAR in a feed.
+func decode(data []byte) ([]*ical.Calendar, error) {
+	var cals []*ical.Calendar
+	dec := ical.NewDecoder(bytes.NewReader(data))
+	for {
+		cal, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		cals = append(cals, cal)
+	}
+	if len(cals) == 0 {
+		return nil, fmt.Errorf("no VCALENDAR found")
+	}
+	return cals, nil
+}
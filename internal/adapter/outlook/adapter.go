@@ -147,13 +147,15 @@ func (o *OutlookAdapter) Calendars() map[string]string {
 // loadCalendarList fetches all calendars the user has access to.
 func (o *OutlookAdapter) loadCalendarList(ctx context.Context) error {
 	result, err := o.client.Me().Calendars().Get(ctx, nil)
-	if err == nil {
-		for _, cal := range result.GetValue() {
-			id := cal.GetId()
-			name := cal.GetName()
-			if id != nil && name != nil {
-				o.calendars[*id] = *name
-			}
+	if err != nil {
+		return err
+	}
+
+	for _, cal := range result.GetValue() {
+		id := cal.GetId()
+		name := cal.GetName()
+		if id != nil && name != nil {
+			o.calendars[*id] = *name
 		}
 	}
 
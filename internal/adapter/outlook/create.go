@@ -0,0 +1,99 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// CreateEvent creates an event and invites its attendees. With
+// core.ConferenceTeams, Outlook attaches a new Teams meeting.
+func (o *OutlookAdapter) CreateEvent(ctx context.Context, calendarID string, draft core.EventDraft) (core.Event, error) {
+	if draft.Conference == core.ConferenceGoogleMeet {
+		return core.Event{}, fmt.Errorf("only Teams meetings can be created in Outlook")
+	}
+
+	event := models.NewEvent()
+	event.SetSubject(&draft.Title)
+	event.SetStart(graphDateTime(draft.Start, draft.IsAllDay))
+	event.SetEnd(graphDateTime(draft.End, draft.IsAllDay))
+	event.SetIsAllDay(&draft.IsAllDay)
+
+	if draft.Description != "" {
+		body := models.NewItemBody()
+		contentType := models.TEXT_BODYTYPE
+		body.SetContentType(&contentType)
+		body.SetContent(&draft.Description)
+		event.SetBody(body)
+	}
+
+	if draft.Location != "" {
+		location := models.NewLocation()
+		location.SetDisplayName(&draft.Location)
+		event.SetLocation(location)
+	}
+
+	var attendees []models.Attendeeable
+	for _, email := range draft.Attendees {
+		address := models.NewEmailAddress()
+		address.SetAddress(&email)
+		attendee := models.NewAttendee()
+		attendee.SetEmailAddress(address)
+		attendeeType := models.REQUIRED_ATTENDEETYPE
+		attendee.SetTypeEscaped(&attendeeType)
+		attendees = append(attendees, attendee)
+	}
+	event.SetAttendees(attendees)
+
+	if draft.Conference == core.ConferenceTeams {
+		online := true
+		provider := models.TEAMSFORBUSINESS_ONLINEMEETINGPROVIDERTYPE
+		event.SetIsOnlineMeeting(&online)
+		event.SetOnlineMeetingProvider(&provider)
+	}
+
+	// Get the created event back in UTC, like fetched ones
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	var created models.Eventable
+	var err error
+	if calendarID == "default" {
+		created, err = o.client.Me().Events().Post(ctx, event, &users.ItemEventsRequestBuilderPostRequestConfiguration{
+			Headers: headers,
+		})
+	} else {
+		created, err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().Post(ctx, event, &users.ItemCalendarsItemEventsRequestBuilderPostRequestConfiguration{
+			Headers: headers,
+		})
+	}
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to create event: %w", err)
+	}
+
+	return parseGraphEvent(o.ID(), created, calendarID, o.calendars[calendarID]), nil
+}
+
+// graphDateTime converts a time to Graph's format. Times are sent in UTC;
+// all-day events must start and end at midnight, so their date is kept.
+func graphDateTime(t time.Time, isAllDay bool) models.DateTimeTimeZoneable {
+	value := t.UTC().Format("2006-01-02T15:04:05")
+	if isAllDay {
+		value = t.Format("2006-01-02") + "T00:00:00"
+	}
+	zone := "UTC"
+
+	dt := models.NewDateTimeTimeZone()
+	dt.SetDateTime(&value)
+	dt.SetTimeZone(&zone)
+	return dt
+}
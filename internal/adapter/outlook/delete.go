@@ -0,0 +1,76 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// DeleteEvent deletes an event the user organizes. Meetings are cancelled
+// through /cancel, which sends attendees the message; events without
+// attendees are simply deleted. With core.RecurringScopeAllInstances the
+// whole series goes; with core.RecurringScopeThisAndFollowing the series is
+// ended the day before the instance, and the message is not sent.
+func (o *OutlookAdapter) DeleteEvent(ctx context.Context, calendarID, eventID string, opts core.DeleteOptions) error {
+	event, err := o.getEvent(ctx, calendarID, eventID)
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	if isCancelled := event.GetIsCancelled(); isCancelled != nil && *isCancelled {
+		return fmt.Errorf("event is already cancelled")
+	}
+	if isOrganizer := event.GetIsOrganizer(); isOrganizer == nil || !*isOrganizer {
+		return core.ErrNotOrganizer
+	}
+
+	if opts.Scope == core.RecurringScopeThisAndFollowing {
+		if seriesMasterId := event.GetSeriesMasterId(); seriesMasterId != nil && *seriesMasterId != "" {
+			return o.deleteFollowing(ctx, calendarID, event, opts)
+		}
+	}
+
+	targetEventID := eventID
+	if opts.Scope == core.RecurringScopeAllInstances {
+		if seriesMasterId := event.GetSeriesMasterId(); seriesMasterId != nil && *seriesMasterId != "" {
+			targetEventID = *seriesMasterId
+		}
+	}
+
+	// Only meetings can be cancelled; /cancel fails on plain appointments
+	if len(event.GetAttendees()) > 0 {
+		if calendarID == "default" {
+			body := users.NewItemEventsItemCancelPostRequestBody()
+			if opts.Message != "" {
+				body.SetComment(&opts.Message)
+			}
+			err = o.client.Me().Events().ByEventId(targetEventID).Cancel().Post(ctx, body, nil)
+		} else {
+			body := users.NewItemCalendarsItemEventsItemCancelPostRequestBody()
+			if opts.Message != "" {
+				body.SetComment(&opts.Message)
+			}
+			err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(targetEventID).Cancel().Post(ctx, body, nil)
+		}
+	} else {
+		if calendarID == "default" {
+			err = o.client.Me().Events().ByEventId(targetEventID).Delete(ctx, nil)
+		} else {
+			err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(targetEventID).Delete(ctx, nil)
+		}
+	}
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	return nil
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
 
+	"github.com/theakshaypant/tsk/internal/adapter/eventutil"
 	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/util"
 )
 
 // FetchEvents retrieves events from the user's calendars matching the given options.
@@ -25,19 +27,28 @@ func (o *OutlookAdapter) FetchEvents(ctx context.Context, opts core.FetchOptions
 		}
 	}
 
+	var lastErr error
+	fetched := 0
 	for _, calID := range calendarIDs {
 		if _, exists := o.calendars[calID]; !exists {
 			continue
 		}
 		events, err := o.fetchEventsFromCalendar(ctx, calID, opts)
 		if err != nil {
+			lastErr = err
 			continue // skip failed calendars
 		}
+		fetched++
 		results = append(results, events...)
 	}
 
-	results = deduplicateEvents(results)
-	sortEventsByStartTime(results)
+	// Every calendar failed — report it instead of returning an empty list
+	if fetched == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	results = eventutil.Deduplicate(results)
+	eventutil.SortByStart(results)
 
 	return results, nil
 }
@@ -48,7 +59,7 @@ func (o *OutlookAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID
 	selectFields := []string{
 		"id", "iCalUId", "subject", "body", "start", "end", "location",
 		"isAllDay", "showAs", "responseStatus", "onlineMeeting", "webLink",
-		"isOrganizer", "isCancelled", "categories",
+		"isOrganizer", "isCancelled", "categories", "organizer", "attendees",
 	}
 	orderBy := []string{"start/dateTime"}
 	top := int32(100)
@@ -110,14 +121,14 @@ func (o *OutlookAdapter) fetchEventsFromCalendar(ctx context.Context, calendarID
 		event := parseGraphEvent(o.ID(), item, calendarID, calendarName)
 
 		// Treat timed events as all-day if they span the entire viewed day
-		if !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
+		if !opts.RawAllDay && !event.IsAllDay && !event.Start.After(opts.Start) && !event.End.Before(opts.End) {
 			event.IsAllDay = true
 		}
 
-		if len(opts.IncludeTypes) > 0 && !containsType(opts.IncludeTypes, event.Type) {
+		if len(opts.IncludeTypes) > 0 && !eventutil.ContainsType(opts.IncludeTypes, event.Type) {
 			return true
 		}
-		if len(opts.IncludeStatuses) > 0 && !containsStatus(opts.IncludeStatuses, event.Status) {
+		if len(opts.IncludeStatuses) > 0 && !eventutil.ContainsStatus(opts.IncludeStatuses, event.Status) {
 			return true
 		}
 		if opts.ExcludeAllDay && event.IsAllDay {
@@ -177,6 +188,13 @@ func parseGraphEvent(providerID string, item models.Eventable, calendarID, calen
 		}
 	}
 
+	// Organizer
+	organizer, organizerName := "", ""
+	if org := item.GetOrganizer(); org != nil && org.GetEmailAddress() != nil {
+		organizer = derefStr(org.GetEmailAddress().GetAddress())
+		organizerName = derefStr(org.GetEmailAddress().GetName())
+	}
+
 	// Location
 	location := ""
 	if loc := item.GetLocation(); loc != nil {
@@ -193,17 +211,48 @@ func parseGraphEvent(providerID string, item models.Eventable, calendarID, calen
 			ID:   calendarID,
 			Name: calendarName,
 		},
-		Type:        eventType,
-		Title:       derefStr(item.GetSubject()),
-		Description: description,
-		Location:    location,
-		Status:      status,
-		URL:         derefStr(item.GetWebLink()),
-		MeetingLink: meetingLink,
-		Start:       startTime,
-		End:         endTime,
-		IsAllDay:    derefBool(item.GetIsAllDay()),
+		Type:          eventType,
+		Title:         derefStr(item.GetSubject()),
+		Description:   description,
+		Location:      location,
+		Status:        status,
+		URL:           derefStr(item.GetWebLink()),
+		MeetingLink:   meetingLink,
+		Organizer:     organizer,
+		OrganizerName: organizerName,
+		Attendees:     parseGraphAttendees(item.GetAttendees()),
+		Start:         startTime,
+		End:           endTime,
+		IsAllDay:      derefBool(item.GetIsAllDay()),
+	}
+}
+
+// parseGraphAttendees converts Graph attendees to core attendees.
+func parseGraphAttendees(items []models.Attendeeable) []core.Attendee {
+	var attendees []core.Attendee
+	for _, item := range items {
+		attendee := core.Attendee{Response: core.StatusAwaiting}
+		if address := item.GetEmailAddress(); address != nil {
+			attendee.Name = derefStr(address.GetName())
+			attendee.Email = derefStr(address.GetAddress())
+		}
+		if attendeeType := item.GetTypeEscaped(); attendeeType != nil {
+			attendee.Optional = *attendeeType == models.OPTIONAL_ATTENDEETYPE
+			attendee.IsResource = *attendeeType == models.RESOURCE_ATTENDEETYPE
+		}
+		if status := item.GetStatus(); status != nil && status.GetResponse() != nil {
+			switch *status.GetResponse() {
+			case models.ACCEPTED_RESPONSETYPE, models.ORGANIZER_RESPONSETYPE:
+				attendee.Response = core.StatusAccepted
+			case models.DECLINED_RESPONSETYPE:
+				attendee.Response = core.StatusRejected
+			case models.TENTATIVELYACCEPTED_RESPONSETYPE:
+				attendee.Response = core.StatusTentative
+			}
+		}
+		attendees = append(attendees, attendee)
 	}
+	return attendees
 }
 
 // parseSDKDateTime converts a Graph SDK DateTimeTimeZone to time.Time.
@@ -280,9 +329,14 @@ func (o *OutlookAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		return fmt.Errorf("cannot respond to a cancelled event")
 	}
 
+	if opts.RecurringScope == core.RecurringScopeThisAndFollowing {
+		if seriesMasterId := event.GetSeriesMasterId(); seriesMasterId != nil && *seriesMasterId != "" {
+			return o.respondToFollowing(ctx, calendarID, event, opts)
+		}
+	}
+
 	// Determine target event ID for recurring events
 	targetEventID := eventID
-	targetCalendarID := calendarID
 
 	// For recurring events, handle scope
 	if opts.RecurringScope == core.RecurringScopeAllInstances {
@@ -304,6 +358,12 @@ func (o *OutlookAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		}
 	}
 
+	return o.sendResponse(ctx, calendarID, targetEventID, event, opts)
+}
+
+// sendResponse validates the user can respond to a fetched event and
+// posts the response.
+func (o *OutlookAdapter) sendResponse(ctx context.Context, targetCalendarID, targetEventID string, event models.Eventable, opts core.RespondOptions) error {
 	// Check if user is the organizer
 	if isOrganizer := event.GetIsOrganizer(); isOrganizer != nil && *isOrganizer {
 		return core.ErrIsOrganizer
@@ -338,7 +398,7 @@ func (o *OutlookAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 		// If the event has a timezone, convert proposed times to that timezone
 		if eventStart := event.GetStart(); eventStart != nil {
 			if tz := eventStart.GetTimeZone(); tz != nil && *tz != "" {
-				if loc, err := time.LoadLocation(*tz); err == nil {
+				if loc, err := util.LoadLocation(*tz); err == nil {
 					proposedStart = proposedStart.In(loc)
 					proposedEnd = proposedEnd.In(loc)
 				}
@@ -360,6 +420,7 @@ func (o *OutlookAdapter) RespondToEvent(ctx context.Context, calendarID, eventID
 	// Use the appropriate accept/decline/tentativelyAccept endpoint
 	// Microsoft Graph has specific endpoints for responding to events
 	sendResponse := true
+	var err error
 
 	switch opts.Response {
 	case core.ResponseAccept:
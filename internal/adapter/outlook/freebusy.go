@@ -0,0 +1,68 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// FreeBusy looks up when each address is busy with getSchedule. Tentative,
+// busy and out-of-office items count as busy. Addresses Graph reports an
+// error for (unknown, or not shared with you) are left out of the result.
+func (o *OutlookAdapter) FreeBusy(ctx context.Context, emails []string, start, end time.Time) (map[string][]core.BusyPeriod, error) {
+	body := users.NewItemCalendarGetSchedulePostRequestBody()
+	body.SetSchedules(emails)
+	body.SetStartTime(graphDateTime(start, false))
+	body.SetEndTime(graphDateTime(end, false))
+
+	// Get the schedule items in UTC, like fetched events
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	resp, err := o.client.Me().Calendar().GetSchedule().PostAsGetSchedulePostResponse(ctx, body, &users.ItemCalendarGetScheduleRequestBuilderPostRequestConfiguration{
+		Headers: headers,
+	})
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return nil, core.ErrInsufficientScope
+		}
+		return nil, fmt.Errorf("failed to get schedules: %w", err)
+	}
+
+	// Graph may change the case of the addresses; key by what was asked for
+	requested := make(map[string]string, len(emails))
+	for _, email := range emails {
+		requested[strings.ToLower(email)] = email
+	}
+
+	busy := make(map[string][]core.BusyPeriod)
+	for _, info := range resp.GetValue() {
+		email, ok := requested[strings.ToLower(derefStr(info.GetScheduleId()))]
+		if !ok || info.GetError() != nil {
+			continue
+		}
+		periods := make([]core.BusyPeriod, 0, len(info.GetScheduleItems()))
+		for _, item := range info.GetScheduleItems() {
+			if status := item.GetStatus(); status != nil {
+				switch *status {
+				case models.FREE_FREEBUSYSTATUS, models.WORKINGELSEWHERE_FREEBUSYSTATUS:
+					continue
+				}
+			}
+			periods = append(periods, core.BusyPeriod{
+				Start: parseSDKDateTime(item.GetStart()),
+				End:   parseSDKDateTime(item.GetEnd()),
+			})
+		}
+		busy[email] = periods
+	}
+
+	return busy, nil
+}
@@ -0,0 +1,343 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// followingWindow bounds how far ahead "this and following" responses
+// reach for series without an end.
+const followingWindow = 365 * 24 * time.Hour
+
+// respondToFollowing responds to an instance and every later instance of
+// its series. Graph has no such scope for attendees, so each instance gets
+// its own response.
+func (o *OutlookAdapter) respondToFollowing(ctx context.Context, calendarID string, event models.Eventable, opts core.RespondOptions) error {
+	at := originalStart(event)
+	instances, err := o.listInstances(ctx, calendarID, *event.GetSeriesMasterId(), at, at.Add(followingWindow))
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch recurring event instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		if derefBool(instance.GetIsCancelled()) {
+			continue
+		}
+		if err := o.sendResponse(ctx, calendarID, *instance.GetId(), instance, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateFollowing changes an instance and every later instance of its
+// series: the series is ended the day before the instance and a new
+// series, with the changes applied, carries on from there.
+func (o *OutlookAdapter) updateFollowing(ctx context.Context, calendarID string, event models.Eventable, update core.EventUpdate) (core.Event, error) {
+	masterID := *event.GetSeriesMasterId()
+	master, err := o.getEvent(ctx, calendarID, masterID)
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to fetch series master event: %w", err)
+	}
+
+	seriesStart, at := parseSDKDateTime(master.GetStart()), originalStart(event)
+	if !at.After(seriesStart) {
+		// Splitting at the first instance changes the whole series
+		update.Scope = core.RecurringScopeAllInstances
+		return o.UpdateEvent(ctx, calendarID, *event.GetId(), update)
+	}
+
+	recurrence := master.GetRecurrence()
+	if recurrence == nil || recurrence.GetPattern() == nil || recurrence.GetRangeEscaped() == nil {
+		return core.Event{}, fmt.Errorf("recurring event has no recurrence pattern")
+	}
+	current := recurrence.GetRangeEscaped()
+	loc := seriesLocation(current)
+	seriesAllDay := derefBool(master.GetIsAllDay())
+
+	// The new series starts where this instance is now, or where it moves to
+	instance := parseGraphEvent(o.ID(), event, calendarID, "")
+	start, end, isAllDay := instance.Start, instance.End, instance.IsAllDay
+	if update.Reschedules() {
+		start, end, isAllDay = update.Start, update.End, update.IsAllDay
+	}
+
+	rangeType := models.NOEND_RECURRENCERANGETYPE
+	if t := current.GetTypeEscaped(); t != nil {
+		rangeType = *t
+	}
+	nextRange := models.NewRecurrenceRange()
+	nextRange.SetTypeEscaped(&rangeType)
+	nextRange.SetStartDate(serialization.NewDateOnly(rangeDate(start, isAllDay, loc)))
+	nextRange.SetRecurrenceTimeZone(current.GetRecurrenceTimeZone())
+	switch rangeType {
+	case models.ENDDATE_RECURRENCERANGETYPE:
+		nextRange.SetEndDate(current.GetEndDate())
+	case models.NUMBERED_RECURRENCERANGETYPE:
+		// Carry over the instances not yet used up
+		used, err := o.listInstances(ctx, calendarID, masterID, seriesStart, at)
+		if err != nil {
+			return core.Event{}, fmt.Errorf("failed to count recurring event instances: %w", err)
+		}
+		remaining := int32(0)
+		if count := current.GetNumberOfOccurrences(); count != nil {
+			remaining = *count - int32(len(used))
+		}
+		if remaining <= 0 {
+			return core.Event{}, fmt.Errorf("the recurring series has no instances left")
+		}
+		nextRange.SetNumberOfOccurrences(&remaining)
+	}
+	nextRecurrence := models.NewPatternedRecurrence()
+	nextRecurrence.SetPattern(copyPattern(recurrence.GetPattern()))
+	nextRecurrence.SetRangeEscaped(nextRange)
+
+	next := models.NewEvent()
+	next.SetSubject(master.GetSubject())
+	if body := master.GetBody(); body != nil {
+		nextBody := models.NewItemBody()
+		nextBody.SetContentType(body.GetContentType())
+		nextBody.SetContent(body.GetContent())
+		next.SetBody(nextBody)
+	}
+	if location := master.GetLocation(); location != nil && location.GetDisplayName() != nil {
+		nextLocation := models.NewLocation()
+		nextLocation.SetDisplayName(location.GetDisplayName())
+		next.SetLocation(nextLocation)
+	}
+	var attendees []models.Attendeeable
+	for _, attendee := range master.GetAttendees() {
+		nextAttendee := models.NewAttendee()
+		nextAttendee.SetEmailAddress(attendee.GetEmailAddress())
+		nextAttendee.SetTypeEscaped(attendee.GetTypeEscaped())
+		attendees = append(attendees, nextAttendee)
+	}
+	next.SetAttendees(attendees)
+	next.SetShowAs(master.GetShowAs())
+	next.SetSensitivity(master.GetSensitivity())
+	next.SetImportance(master.GetImportance())
+	next.SetCategories(master.GetCategories())
+	next.SetIsReminderOn(master.GetIsReminderOn())
+	next.SetReminderMinutesBeforeStart(master.GetReminderMinutesBeforeStart())
+	if derefBool(master.GetIsOnlineMeeting()) {
+		next.SetIsOnlineMeeting(master.GetIsOnlineMeeting())
+		next.SetOnlineMeetingProvider(master.GetOnlineMeetingProvider())
+	}
+	next.SetStart(graphDateTime(start, isAllDay))
+	next.SetEnd(graphDateTime(end, isAllDay))
+	next.SetIsAllDay(&isAllDay)
+	next.SetRecurrence(nextRecurrence)
+
+	if update.Title != nil {
+		next.SetSubject(update.Title)
+	}
+	if update.Description != nil {
+		body := models.NewItemBody()
+		contentType := models.TEXT_BODYTYPE
+		body.SetContentType(&contentType)
+		body.SetContent(update.Description)
+		next.SetBody(body)
+	}
+	if update.Location != nil {
+		location := models.NewLocation()
+		location.SetDisplayName(update.Location)
+		next.SetLocation(location)
+	}
+	if len(update.AddAttendees) > 0 || len(update.RemoveAttendees) > 0 {
+		next.SetAttendees(mergeAttendees(next.GetAttendees(), update.AddAttendees, update.RemoveAttendees))
+	}
+
+	// Create the new series before ending the old one: a failure then
+	// leaves a duplicate behind rather than losing the later instances
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	var created models.Eventable
+	if calendarID == "default" {
+		created, err = o.client.Me().Events().Post(ctx, next, &users.ItemEventsRequestBuilderPostRequestConfiguration{
+			Headers: headers,
+		})
+	} else {
+		created, err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().Post(ctx, next, &users.ItemCalendarsItemEventsRequestBuilderPostRequestConfiguration{
+			Headers: headers,
+		})
+	}
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to create the new series: %w", err)
+	}
+
+	if err := o.endSeries(ctx, calendarID, masterID, recurrence, rangeDate(at, seriesAllDay, loc)); err != nil {
+		return core.Event{}, err
+	}
+
+	return parseGraphEvent(o.ID(), created, calendarID, o.calendars[calendarID]), nil
+}
+
+// deleteFollowing deletes an instance and every later instance of its
+// series by ending the series the day before it.
+func (o *OutlookAdapter) deleteFollowing(ctx context.Context, calendarID string, event models.Eventable, opts core.DeleteOptions) error {
+	masterID := *event.GetSeriesMasterId()
+	master, err := o.getEvent(ctx, calendarID, masterID)
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to fetch series master event: %w", err)
+	}
+
+	if at := originalStart(event); !at.After(parseSDKDateTime(master.GetStart())) {
+		// Nothing is left before the first instance
+		opts.Scope = core.RecurringScopeAllInstances
+		return o.DeleteEvent(ctx, calendarID, *event.GetId(), opts)
+	}
+
+	recurrence := master.GetRecurrence()
+	if recurrence == nil || recurrence.GetPattern() == nil || recurrence.GetRangeEscaped() == nil {
+		return fmt.Errorf("recurring event has no recurrence pattern")
+	}
+	at := rangeDate(originalStart(event), derefBool(master.GetIsAllDay()), seriesLocation(recurrence.GetRangeEscaped()))
+	return o.endSeries(ctx, calendarID, masterID, recurrence, at)
+}
+
+// endSeries ends a series on the day before the given date.
+func (o *OutlookAdapter) endSeries(ctx context.Context, calendarID, masterID string, recurrence models.PatternedRecurrenceable, at time.Time) error {
+	current := recurrence.GetRangeEscaped()
+	endType := models.ENDDATE_RECURRENCERANGETYPE
+	truncated := models.NewRecurrenceRange()
+	truncated.SetTypeEscaped(&endType)
+	truncated.SetStartDate(current.GetStartDate())
+	truncated.SetEndDate(serialization.NewDateOnly(at.AddDate(0, 0, -1)))
+	truncated.SetRecurrenceTimeZone(current.GetRecurrenceTimeZone())
+
+	patched := models.NewPatternedRecurrence()
+	patched.SetPattern(copyPattern(recurrence.GetPattern()))
+	patched.SetRangeEscaped(truncated)
+	patch := models.NewEvent()
+	patch.SetRecurrence(patched)
+
+	var err error
+	if calendarID == "default" {
+		_, err = o.client.Me().Events().ByEventId(masterID).Patch(ctx, patch, nil)
+	} else {
+		_, err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(masterID).Patch(ctx, patch, nil)
+	}
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.ErrInsufficientScope
+		}
+		return fmt.Errorf("failed to end the recurring series: %w", err)
+	}
+	return nil
+}
+
+// listInstances fetches the instances of a series between two times.
+func (o *OutlookAdapter) listInstances(ctx context.Context, calendarID, masterID string, start, end time.Time) ([]models.Eventable, error) {
+	startStr := start.UTC().Format(time.RFC3339)
+	endStr := end.UTC().Format(time.RFC3339)
+
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	var result models.EventCollectionResponseable
+	var err error
+	if calendarID == "default" {
+		result, err = o.client.Me().Events().ByEventId(masterID).Instances().Get(ctx, &users.ItemEventsItemInstancesRequestBuilderGetRequestConfiguration{
+			QueryParameters: &users.ItemEventsItemInstancesRequestBuilderGetQueryParameters{
+				StartDateTime: &startStr,
+				EndDateTime:   &endStr,
+			},
+			Headers: headers,
+		})
+	} else {
+		result, err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(masterID).Instances().Get(ctx, &users.ItemCalendarsItemEventsItemInstancesRequestBuilderGetRequestConfiguration{
+			QueryParameters: &users.ItemCalendarsItemEventsItemInstancesRequestBuilderGetQueryParameters{
+				StartDateTime: &startStr,
+				EndDateTime:   &endStr,
+			},
+			Headers: headers,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []models.Eventable
+	pageIterator, err := msgraphcore.NewPageIterator[models.Eventable](
+		result,
+		o.client.GetAdapter(),
+		models.CreateEventCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create page iterator: %w", err)
+	}
+	err = pageIterator.Iterate(ctx, func(item models.Eventable) bool {
+		instances = append(instances, item)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate instances: %w", err)
+	}
+	return instances, nil
+}
+
+// originalStart returns when an instance was scheduled by its series,
+// before any change to that instance alone.
+func originalStart(event models.Eventable) time.Time {
+	if t := event.GetOriginalStart(); t != nil {
+		return t.UTC()
+	}
+	return parseSDKDateTime(event.GetStart())
+}
+
+// seriesLocation returns the zone a series' dates are in. Graph often
+// uses Windows zone names; unknown zones fall back to the local zone.
+func seriesLocation(r models.RecurrenceRangeable) *time.Location {
+	if zone := r.GetRecurrenceTimeZone(); zone != nil {
+		if loc, err := util.LoadLocation(*zone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// rangeDate returns the date a time falls on in a series' range. All-day
+// times already carry their date.
+func rangeDate(t time.Time, isAllDay bool, loc *time.Location) time.Time {
+	if !isAllDay {
+		t = t.In(loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// copyPattern copies a recurrence pattern field by field, so it is sent
+// in full rather than only the fields changed since it was fetched.
+func copyPattern(p models.RecurrencePatternable) models.RecurrencePatternable {
+	pattern := models.NewRecurrencePattern()
+	pattern.SetTypeEscaped(p.GetTypeEscaped())
+	pattern.SetInterval(p.GetInterval())
+	pattern.SetMonth(p.GetMonth())
+	pattern.SetDayOfMonth(p.GetDayOfMonth())
+	pattern.SetDaysOfWeek(p.GetDaysOfWeek())
+	pattern.SetFirstDayOfWeek(p.GetFirstDayOfWeek())
+	pattern.SetIndex(p.GetIndex())
+	return pattern
+}
@@ -0,0 +1,117 @@
+package outlook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// deltaPage is one page of a calendarView delta response.
+type deltaPage interface {
+	GetValue() []models.Eventable
+	GetOdataNextLink() *string
+	GetOdataDeltaLink() *string
+}
+
+// SyncChanges returns what changed in a calendar since the sync that produced
+// token (a Graph calendarView delta link). An empty token starts a new delta
+// round for [start, end), which returns every event in the window.
+func (o *OutlookAdapter) SyncChanges(ctx context.Context, calendarID string, start, end time.Time, token string) (*core.ChangeSet, error) {
+	calendarName := o.calendars[calendarID]
+	changes := &core.ChangeSet{Full: token == ""}
+	link := token
+
+	for {
+		page, err := o.fetchDeltaPage(ctx, calendarID, link, start, end)
+		if err != nil {
+			if isSyncStateExpired(err) {
+				return nil, core.ErrSyncTokenExpired
+			}
+			return nil, fmt.Errorf("sync calendar view: %w", err)
+		}
+		if page == nil {
+			return nil, fmt.Errorf("sync calendar view: empty response")
+		}
+
+		for _, item := range page.GetValue() {
+			// Removed events only carry their ID and an @removed annotation
+			if _, removed := item.GetAdditionalData()["@removed"]; removed || derefBool(item.GetIsCancelled()) {
+				changes.Deleted = append(changes.Deleted, derefStr(item.GetId()))
+				continue
+			}
+			changes.Events = append(changes.Events, parseGraphEvent(o.ID(), item, calendarID, calendarName))
+		}
+
+		if next := derefStr(page.GetOdataNextLink()); next != "" {
+			link = next
+			continue
+		}
+		changes.Token = derefStr(page.GetOdataDeltaLink())
+		break
+	}
+
+	return changes, nil
+}
+
+// fetchDeltaPage requests one page of the calendarView delta of a calendar.
+// An empty link starts a new round for [start, end); otherwise the next or
+// delta link of a previous page is followed.
+func (o *OutlookAdapter) fetchDeltaPage(ctx context.Context, calendarID, link string, start, end time.Time) (deltaPage, error) {
+	startStr := start.UTC().Format(time.RFC3339)
+	endStr := end.UTC().Format(time.RFC3339)
+
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`, "odata.maxpagesize=100")
+
+	if calendarID == "default" {
+		builder := o.client.Me().CalendarView().Delta()
+		config := &users.ItemCalendarViewDeltaRequestBuilderGetRequestConfiguration{Headers: headers}
+		if link != "" {
+			builder = builder.WithUrl(link)
+		} else {
+			config.QueryParameters = &users.ItemCalendarViewDeltaRequestBuilderGetQueryParameters{
+				StartDateTime: &startStr,
+				EndDateTime:   &endStr,
+			}
+		}
+		return builder.GetAsDeltaGetResponse(ctx, config)
+	}
+
+	builder := o.client.Me().Calendars().ByCalendarId(calendarID).CalendarView().Delta()
+	config := &users.ItemCalendarsItemCalendarViewDeltaRequestBuilderGetRequestConfiguration{Headers: headers}
+	if link != "" {
+		builder = builder.WithUrl(link)
+	} else {
+		config.QueryParameters = &users.ItemCalendarsItemCalendarViewDeltaRequestBuilderGetQueryParameters{
+			StartDateTime: &startStr,
+			EndDateTime:   &endStr,
+		}
+	}
+	return builder.GetAsDeltaGetResponse(ctx, config)
+}
+
+// isSyncStateExpired checks if Graph rejected a delta link as expired.
+func isSyncStateExpired(err error) bool {
+	var odataErr *odataerrors.ODataError
+	if !errors.As(err, &odataErr) {
+		return false
+	}
+	if odataErr.GetStatusCode() == http.StatusGone {
+		return true
+	}
+	if mainErr := odataErr.GetErrorEscaped(); mainErr != nil {
+		code := strings.ToLower(derefStr(mainErr.GetCode()))
+		return code == "syncstatenotfound" || code == "syncstateinvalid" || code == "resyncrequired"
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package outlook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+)
+
+// UpdateEvent patches an event the user organizes; Outlook notifies the
+// attendees. With core.RecurringScopeAllInstances the series master is
+// patched instead, so every instance changes; with
+// core.RecurringScopeThisAndFollowing the series is split at the instance.
+func (o *OutlookAdapter) UpdateEvent(ctx context.Context, calendarID, eventID string, update core.EventUpdate) (core.Event, error) {
+	event, err := o.getEvent(ctx, calendarID, eventID)
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to fetch event: %w", err)
+	}
+
+	if isCancelled := event.GetIsCancelled(); isCancelled != nil && *isCancelled {
+		return core.Event{}, fmt.Errorf("cannot change a cancelled event")
+	}
+	if isOrganizer := event.GetIsOrganizer(); isOrganizer == nil || !*isOrganizer {
+		return core.Event{}, core.ErrNotOrganizer
+	}
+
+	if update.Scope == core.RecurringScopeThisAndFollowing {
+		if seriesMasterId := event.GetSeriesMasterId(); seriesMasterId != nil && *seriesMasterId != "" {
+			return o.updateFollowing(ctx, calendarID, event, update)
+		}
+	}
+
+	targetEventID := eventID
+	start, end := update.Start, update.End
+	if update.Scope == core.RecurringScopeAllInstances {
+		if seriesMasterId := event.GetSeriesMasterId(); seriesMasterId != nil && *seriesMasterId != "" {
+			master, err := o.getEvent(ctx, calendarID, *seriesMasterId)
+			if err != nil {
+				if isInsufficientScopeError(err) {
+					return core.Event{}, core.ErrInsufficientScope
+				}
+				return core.Event{}, fmt.Errorf("failed to fetch series master event: %w", err)
+			}
+			if update.Reschedules() {
+				// Move the series by as much as this instance moves
+				start, end = recurrence.MoveSeries(
+					parseGraphEvent(o.ID(), master, calendarID, ""),
+					parseGraphEvent(o.ID(), event, calendarID, ""),
+					update.Start, update.End, update.IsAllDay)
+			}
+			targetEventID = *seriesMasterId
+			event = master
+		}
+	}
+
+	patch := models.NewEvent()
+	if update.Title != nil {
+		patch.SetSubject(update.Title)
+	}
+	if update.Description != nil {
+		body := models.NewItemBody()
+		contentType := models.TEXT_BODYTYPE
+		body.SetContentType(&contentType)
+		body.SetContent(update.Description)
+		patch.SetBody(body)
+	}
+	if update.Location != nil {
+		location := models.NewLocation()
+		location.SetDisplayName(update.Location)
+		patch.SetLocation(location)
+	}
+	if update.Reschedules() {
+		isAllDay := update.IsAllDay
+		patch.SetStart(graphDateTime(start, isAllDay))
+		patch.SetEnd(graphDateTime(end, isAllDay))
+		patch.SetIsAllDay(&isAllDay)
+	}
+	if len(update.AddAttendees) > 0 || len(update.RemoveAttendees) > 0 {
+		patch.SetAttendees(mergeAttendees(event.GetAttendees(), update.AddAttendees, update.RemoveAttendees))
+	}
+
+	// Get the updated event back in UTC, like fetched ones
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	var updated models.Eventable
+	if calendarID == "default" {
+		updated, err = o.client.Me().Events().ByEventId(targetEventID).Patch(ctx, patch, &users.ItemEventsEventItemRequestBuilderPatchRequestConfiguration{
+			Headers: headers,
+		})
+	} else {
+		updated, err = o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(targetEventID).Patch(ctx, patch, &users.ItemCalendarsItemEventsEventItemRequestBuilderPatchRequestConfiguration{
+			Headers: headers,
+		})
+	}
+	if err != nil {
+		if isInsufficientScopeError(err) {
+			return core.Event{}, core.ErrInsufficientScope
+		}
+		return core.Event{}, fmt.Errorf("failed to update event: %w", err)
+	}
+
+	return parseGraphEvent(o.ID(), updated, calendarID, o.calendars[calendarID]), nil
+}
+
+// getEvent fetches a single event with its times in UTC.
+func (o *OutlookAdapter) getEvent(ctx context.Context, calendarID, eventID string) (models.Eventable, error) {
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", `outlook.timezone="UTC"`)
+
+	if calendarID == "default" {
+		return o.client.Me().Events().ByEventId(eventID).Get(ctx, &users.ItemEventsEventItemRequestBuilderGetRequestConfiguration{
+			Headers: headers,
+		})
+	}
+	return o.client.Me().Calendars().ByCalendarId(calendarID).Events().ByEventId(eventID).Get(ctx, &users.ItemCalendarsItemEventsEventItemRequestBuilderGetRequestConfiguration{
+		Headers: headers,
+	})
+}
+
+// mergeAttendees applies invitations and removals to a guest list, keeping
+// the existing entries (and their responses) as they are.
+func mergeAttendees(current []models.Attendeeable, add, remove []string) []models.Attendeeable {
+	removed := make(map[string]bool, len(remove))
+	for _, email := range remove {
+		removed[strings.ToLower(email)] = true
+	}
+
+	result := []models.Attendeeable{}
+	present := make(map[string]bool)
+	for _, attendee := range current {
+		email := ""
+		if address := attendee.GetEmailAddress(); address != nil && address.GetAddress() != nil {
+			email = strings.ToLower(*address.GetAddress())
+		}
+		if removed[email] {
+			continue
+		}
+		present[email] = true
+		result = append(result, attendee)
+	}
+	for _, email := range add {
+		if key := strings.ToLower(email); !present[key] && !removed[key] {
+			present[key] = true
+			address := models.NewEmailAddress()
+			address.SetAddress(&email)
+			attendee := models.NewAttendee()
+			attendee.SetEmailAddress(address)
+			attendeeType := models.REQUIRED_ATTENDEETYPE
+			attendee.SetTypeEscaped(&attendeeType)
+			result = append(result, attendee)
+		}
+	}
+	return result
+}
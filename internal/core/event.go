@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"time"
 )
 
@@ -57,6 +58,32 @@ type Attachment struct {
 	MimeType string
 }
 
+// Attendee is a person or resource invited to an event.
+type Attendee struct {
+	Name  string
+	Email string
+	// Optional attendees are invited but not required to attend
+	Optional bool
+	// Their response; StatusAwaiting until they answer
+	Response EventStatus
+	// Rooms and equipment booked through the invitation
+	IsResource bool
+}
+
+// AttendeeSummary counts the responses of an event's attendees.
+// Resources are left out, since rooms answer automatically.
+type AttendeeSummary struct {
+	Accepted  int
+	Declined  int
+	Tentative int
+	Pending   int
+}
+
+// Total returns the number of people counted.
+func (s AttendeeSummary) Total() int {
+	return s.Accepted + s.Declined + s.Tentative + s.Pending
+}
+
 // All adapters (Google, Outlook, etc.) must convert their data to this format.
 type Event struct {
 	// Unique ID (provided by the source)
@@ -81,6 +108,13 @@ type Event struct {
 	URL string
 	// Video conferencing link (Google Meet, Zoom, Teams, etc.)
 	MeetingLink string
+	// Email address of the organizer (empty if unknown)
+	Organizer string
+	// Display name of the organizer (empty if unknown)
+	OrganizerName string
+	// Invited people and resources, the organizer included when the
+	// provider lists them. Empty for events without guests.
+	Attendees   []Attendee
 	Attachments []Attachment
 	// Timing
 	Start    time.Time
@@ -109,11 +143,50 @@ func (e Event) NeedsResponse() bool {
 	return e.Status == StatusAwaiting
 }
 
+// AttendeeSummary counts how the attendees have responded.
+func (e Event) AttendeeSummary() AttendeeSummary {
+	var summary AttendeeSummary
+	for _, attendee := range e.Attendees {
+		if attendee.IsResource {
+			continue
+		}
+		switch attendee.Response {
+		case StatusAccepted:
+			summary.Accepted++
+		case StatusRejected:
+			summary.Declined++
+		case StatusTentative:
+			summary.Tentative++
+		default:
+			summary.Pending++
+		}
+	}
+	return summary
+}
+
 // IsRecurring checks if this event is part of a recurring series.
 func (e Event) IsRecurring() bool {
 	return e.RecurringEventID != ""
 }
 
+// MatchesQuery reports whether every word of query appears, ignoring case,
+// in the event's title, description, location, organizer or attendees.
+// This is how events are searched where the provider can't do it.
+func (e Event) MatchesQuery(query string) bool {
+	fields := []string{e.Title, e.Description, e.Location, e.Organizer, e.OrganizerName}
+	for _, a := range e.Attendees {
+		fields = append(fields, a.Name, a.Email)
+	}
+	text := strings.ToLower(strings.Join(fields, "\n"))
+
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
 // GetProposedTime extracts a proposed time from event metadata if present.
 // Returns nil if no proposal is found. Looks for properties set by tsk or
 // other compatible clients using the "tsk:proposedStart" and "tsk:proposedEnd" keys.
@@ -176,6 +249,9 @@ const (
 	RecurringScopeThisInstance RecurringScope = iota
 	// RecurringScopeAllInstances responds to all instances (past and future)
 	RecurringScopeAllInstances
+	// RecurringScopeThisAndFollowing responds to this instance and every
+	// later one, leaving past instances as they are
+	RecurringScopeThisAndFollowing
 )
 
 // String returns a human-readable representation of the recurring scope.
@@ -185,6 +261,8 @@ func (r RecurringScope) String() string {
 		return "This event only"
 	case RecurringScopeAllInstances:
 		return "All events in the series"
+	case RecurringScopeThisAndFollowing:
+		return "This and following events"
 	default:
 		return "Unknown"
 	}
@@ -203,3 +281,84 @@ type RespondOptions struct {
 	ProposedTime   *TimeProposal
 	RecurringScope RecurringScope // Only used for recurring events
 }
+
+// ConferenceType selects the video call a provider creates with an event.
+type ConferenceType int
+
+const (
+	ConferenceNone ConferenceType = iota
+	// Google Meet (Google Calendar only)
+	ConferenceGoogleMeet
+	// Microsoft Teams (Outlook only)
+	ConferenceTeams
+)
+
+// String returns a human-readable representation of the conference type.
+func (c ConferenceType) String() string {
+	switch c {
+	case ConferenceNone:
+		return "None"
+	case ConferenceGoogleMeet:
+		return "Google Meet"
+	case ConferenceTeams:
+		return "Microsoft Teams"
+	default:
+		return "Unknown"
+	}
+}
+
+// EventDraft describes an event to be created.
+type EventDraft struct {
+	Title       string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	// All-day events use the dates of Start and End; End is exclusive
+	IsAllDay bool
+	// Email addresses of the people to invite
+	Attendees []string
+	// Conference asks the provider to create a video call for the event
+	Conference ConferenceType
+}
+
+// EventUpdate describes changes to an existing event.
+// Nil fields and zero times are left unchanged.
+type EventUpdate struct {
+	Title       *string
+	Description *string
+	Location    *string
+	// Start and End move the event and are set together. All-day events
+	// use the dates of Start and End; End is exclusive
+	Start    time.Time
+	End      time.Time
+	IsAllDay bool
+	// Email addresses to invite, and to remove from the guest list
+	AddAttendees    []string
+	RemoveAttendees []string
+	// Scope selects the instances of a recurring event that change.
+	// With RecurringScopeAllInstances, a new time moves the whole series
+	// by the same offset as the selected instance. With
+	// RecurringScopeThisAndFollowing the series is split in two at the
+	// selected instance, and the new series starts at the new time.
+	Scope RecurringScope
+}
+
+// IsEmpty reports whether the update changes nothing.
+func (u EventUpdate) IsEmpty() bool {
+	return u.Title == nil && u.Description == nil && u.Location == nil &&
+		u.Start.IsZero() && len(u.AddAttendees) == 0 && len(u.RemoveAttendees) == 0
+}
+
+// Reschedules reports whether the update moves the event.
+func (u EventUpdate) Reschedules() bool {
+	return !u.Start.IsZero()
+}
+
+// DeleteOptions configures how an event is deleted.
+type DeleteOptions struct {
+	// Message is sent to the attendees with the cancellation
+	Message string
+	// Scope selects the instances of a recurring event that are deleted
+	Scope RecurringScope
+}
@@ -25,6 +25,11 @@ type FetchOptions struct {
 
 	// ExcludeAllDay filters out all-day events when true.
 	ExcludeAllDay bool
+
+	// RawAllDay keeps IsAllDay as the provider reports it. Otherwise timed
+	// events spanning the entire range are marked all-day, which describes
+	// the view rather than the event and mustn't be stored.
+	RawAllDay bool
 }
 
 // DefaultFetchOptions returns sensible defaults (regular events, all statuses).
@@ -42,7 +47,10 @@ var (
 	ErrNotImplemented    = errors.New("operation not supported by this provider")
 	ErrNotAttendee       = errors.New("you are not an attendee of this event")
 	ErrIsOrganizer       = errors.New("you cannot respond to your own event")
+	ErrNotOrganizer      = errors.New("only the organizer can change this event")
 	ErrInsufficientScope = errors.New("insufficient OAuth scope - re-authentication required")
+	ErrOffline           = errors.New("not available while offline")
+	ErrSyncTokenExpired  = errors.New("sync token expired - full resync required")
 )
 
 // Provider represents a calendar source (Google, iCloud, Local .ics, etc).
@@ -57,3 +65,86 @@ type Provider interface {
 	// RespondToEvent responds to an event invitation with the specified response.
 	RespondToEvent(ctx context.Context, calendarID, eventID string, opts RespondOptions) error
 }
+
+// CalendarAdapter extends Provider with login and calendar listing.
+// Every concrete adapter (Google, Outlook, ...) implements this interface.
+type CalendarAdapter interface {
+	Provider
+	// Login authenticates and loads the calendar list.
+	Login(ctx context.Context) error
+	// Calendars returns the available calendars (ID -> Name).
+	Calendars() map[string]string
+}
+
+// EventCreator is implemented by providers that can create events.
+type EventCreator interface {
+	// CreateEvent creates an event in a calendar, inviting the draft's
+	// attendees, and returns it as stored by the provider.
+	CreateEvent(ctx context.Context, calendarID string, draft EventDraft) (Event, error)
+}
+
+// EventUpdater is implemented by providers that can change existing events.
+type EventUpdater interface {
+	// UpdateEvent applies update to an event the user organizes, notifying
+	// its attendees, and returns the event as stored by the provider. For
+	// recurring events, update.Scope selects the instances that change.
+	// Returns ErrNotOrganizer for events organized by someone else.
+	UpdateEvent(ctx context.Context, calendarID, eventID string, update EventUpdate) (Event, error)
+}
+
+// EventDeleter is implemented by providers that can delete events.
+type EventDeleter interface {
+	// DeleteEvent deletes an event the user organizes. Attendees are sent
+	// a cancellation, with opts.Message when given. For recurring events,
+	// opts.Scope selects the instances that are deleted.
+	// Returns ErrNotOrganizer for events organized by someone else.
+	DeleteEvent(ctx context.Context, calendarID, eventID string, opts DeleteOptions) error
+}
+
+// EventSearcher is implemented by providers that can search events on the
+// server, without fetching everything in the window first.
+type EventSearcher interface {
+	// SearchEvents returns the events matching opts whose title,
+	// description, location or attendees contain query. How the text
+	// matches is up to the server; Event.MatchesQuery is the fallback
+	// for providers that can't search.
+	SearchEvents(ctx context.Context, query string, opts FetchOptions) ([]Event, error)
+}
+
+// FreeBusyChecker is implemented by providers that can look up when other
+// people are busy, without access to their events.
+type FreeBusyChecker interface {
+	// FreeBusy returns the busy periods of each address within [start, end),
+	// keyed by the address as given. Addresses the provider can't look up
+	// (unknown, or not sharing their free/busy) are left out.
+	FreeBusy(ctx context.Context, emails []string, start, end time.Time) (map[string][]BusyPeriod, error)
+}
+
+// BusyPeriod is a stretch of time in which someone is busy.
+type BusyPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ChangeSyncer is implemented by providers that can report only what changed
+// in a calendar since a previous sync (Google sync tokens, Graph delta links).
+type ChangeSyncer interface {
+	// SyncChanges returns the changes to a calendar within [start, end) since
+	// the sync that produced token. An empty token requests a full sync.
+	// If the provider no longer accepts the token, ErrSyncTokenExpired is
+	// returned and the caller should start over with an empty token.
+	SyncChanges(ctx context.Context, calendarID string, start, end time.Time, token string) (*ChangeSet, error)
+}
+
+// ChangeSet is the result of an incremental sync of one calendar.
+type ChangeSet struct {
+	// Events that were created or updated, unfiltered
+	Events []Event
+	// Deleted holds the IDs of removed or cancelled events
+	Deleted []string
+	// Token is passed to the next SyncChanges call
+	Token string
+	// Full is true when Events is a complete snapshot of the window
+	// rather than a list of changes
+	Full bool
+}
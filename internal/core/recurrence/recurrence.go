@@ -0,0 +1,366 @@
+// Package recurrence expands recurring events (RFC 5545 RRULE, RDATE,
+// EXDATE and RECURRENCE-ID overrides) into single instances.
+//
+// Providers backed by Google Calendar and Microsoft Graph get instances
+// from the server. Providers that read iCalendar data directly get a
+// master event with rules instead, and use this package to expand it.
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// idTimeFormat is how an instance's original start is encoded in its ID.
+const idTimeFormat = "20060102T150405Z"
+
+// Series is a recurring event: the master event, the rules that repeat it
+// and the exceptions to them.
+type Series struct {
+	// Master is the event as defined by DTSTART/DTEND. The location of
+	// Master.Start is the event's time zone: instances keep its wall-clock
+	// time across DST changes.
+	Master core.Event
+	// Rules are RRULE values, e.g. "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"
+	Rules []string
+	// Dates are extra occurrences (RDATE)
+	Dates []time.Time
+	// Exclusions are removed occurrences (EXDATE)
+	Exclusions []time.Time
+	// Overrides are occurrences that were changed or cancelled
+	Overrides []Override
+}
+
+// Override replaces a single occurrence of a series.
+type Override struct {
+	// RecurrenceID is the original start of the occurrence (RECURRENCE-ID)
+	RecurrenceID time.Time
+	// Event is the changed occurrence; ignored when Cancelled
+	Event core.Event
+	// Cancelled removes the occurrence
+	Cancelled bool
+}
+
+// Expand returns the instances of the series that overlap the window
+// [opts.Start, opts.End), sorted by start time. Only the window is taken
+// from opts; filtering by type, status and so on is left to the caller.
+//
+// Each instance is a copy of the master (or its override) with
+// RecurringEventID set to the master's ID and an ID built by InstanceID.
+// Instances keep the master's DedupeKey, like provider instances share
+// the series' ICalUID.
+// DTSTART always counts as an occurrence, even if the rules don't match it.
+func Expand(s Series, opts core.FetchOptions) ([]core.Event, error) {
+	if opts.End.IsZero() {
+		return nil, fmt.Errorf("expand %s: the window needs an end", s.Master.ID)
+	}
+
+	// Widen the window so occurrences that started before it but are
+	// still running are found
+	from := opts.Start
+	if s.Master.IsAllDay {
+		from = from.AddDate(0, 0, -allDayLength(s.Master))
+	} else {
+		from = from.Add(-s.Master.Duration())
+	}
+
+	starts, err := occurrences(s, from, opts.End)
+	if err != nil {
+		return nil, fmt.Errorf("expand %s: %w", s.Master.ID, err)
+	}
+
+	overrides := make(map[int64]Override, len(s.Overrides))
+	for _, o := range s.Overrides {
+		overrides[o.RecurrenceID.Unix()] = o
+	}
+
+	var result []core.Event
+	for _, start := range starts {
+		if _, overridden := overrides[start.Unix()]; overridden {
+			continue // added below, it may have moved
+		}
+		if event := instance(s.Master, start); overlaps(event, opts.Start, opts.End) {
+			result = append(result, event)
+		}
+	}
+
+	for _, o := range s.Overrides {
+		if o.Cancelled || isExcluded(s.Exclusions, o.RecurrenceID) {
+			continue
+		}
+		event := o.Event
+		event.ID = InstanceID(s.Master.ID, o.RecurrenceID)
+		event.RecurringEventID = s.Master.ID
+		event.DedupeKey = s.Master.DedupeKey
+		if overlaps(event, opts.Start, opts.End) {
+			result = append(result, event)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result, nil
+}
+
+// InstanceID builds the ID of one instance of a recurring event from the
+// master's ID and the instance's original start.
+func InstanceID(masterID string, recurrenceID time.Time) string {
+	return masterID + "_" + recurrenceID.UTC().Format(idTimeFormat)
+}
+
+// SplitID splits an ID built by InstanceID. recurrenceID is zero when id
+// isn't an instance ID.
+func SplitID(id string) (masterID string, recurrenceID time.Time) {
+	i := strings.LastIndex(id, "_")
+	if i < 0 {
+		return id, time.Time{}
+	}
+	t, err := time.Parse(idTimeFormat, id[i+1:])
+	if err != nil {
+		return id, time.Time{}
+	}
+	return id[:i], t
+}
+
+// MoveSeries returns the new start and end of a series master when one of
+// its instances moves to [start, end). The master moves by the same offset,
+// counted in calendar days when either side is all-day, and takes the
+// instance's new length. The rules are left alone, so moving a weekly
+// series to another weekday still repeats on the old one.
+func MoveSeries(master, instance core.Event, start, end time.Time, isAllDay bool) (time.Time, time.Time) {
+	if !isAllDay && !master.IsAllDay && !instance.IsAllDay {
+		newStart := master.Start.Add(start.Sub(instance.Start))
+		return newStart, newStart.Add(end.Sub(start))
+	}
+
+	day := master.Start.AddDate(0, 0, daysBetween(instance.Start, start))
+	if isAllDay {
+		newStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, start.Location())
+		return newStart, newStart.AddDate(0, 0, daysBetween(start, end))
+	}
+	newStart := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+	return newStart, newStart.Add(end.Sub(start))
+}
+
+// SplitRules splits the rules of a series starting at dtstart in two: the
+// rules of a series that ends just before at, and of one that starts at
+// at. Only RRULE lines change; other lines (EXDATE, RDATE) are kept in
+// both, as dates outside a series are ignored. A COUNT is shared out: the
+// first series ends with UNTIL, the second repeats the occurrences left.
+// at should be an occurrence after dtstart.
+func SplitRules(rules []string, dtstart time.Time, isAllDay bool, at time.Time) (before, after []string, err error) {
+	loc := dtstart.Location()
+	for _, line := range rules {
+		value, prefix := line, ""
+		if strings.HasPrefix(strings.ToUpper(line), "RRULE:") {
+			value, prefix = line[len("RRULE:"):], line[:len("RRULE:")]
+		} else if !strings.Contains(strings.ToUpper(line), "FREQ=") {
+			before = append(before, line)
+			after = append(after, line)
+			continue
+		}
+
+		r, err := parseRule(value, dtstart, isAllDay)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var parts []string
+		count := 0
+		for _, part := range strings.Split(value, ";") {
+			upper := strings.ToUpper(part)
+			if n, ok := strings.CutPrefix(upper, "COUNT="); ok {
+				count, _ = strconv.Atoi(n)
+				continue
+			}
+			if strings.HasPrefix(upper, "UNTIL=") {
+				continue
+			}
+			parts = append(parts, part)
+		}
+		base := strings.Join(parts, ";")
+
+		until := at.Add(-time.Second).UTC().Format(idTimeFormat)
+		if isAllDay {
+			until = at.AddDate(0, 0, -1).Format("20060102")
+		}
+		before = append(before, prefix+base+";UNTIL="+until)
+
+		if count == 0 {
+			after = append(after, line)
+			continue
+		}
+		past := r.Between(floating(dtstart, loc), floating(at, loc).Add(-time.Second), true)
+		if len(past) >= count {
+			return nil, nil, fmt.Errorf("the series ends before %s", at.Format("2006-01-02"))
+		}
+		after = append(after, fmt.Sprintf("%s%s;COUNT=%d", prefix, base, count-len(past)))
+	}
+	return before, after, nil
+}
+
+// daysBetween counts the calendar days from the date of a to the date of b,
+// each read in its own zone.
+func daysBetween(a, b time.Time) int {
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// occurrences returns the start times of the series in [from, to],
+// without exclusions, sorted and without duplicates.
+func occurrences(s Series, from, to time.Time) ([]time.Time, error) {
+	dtstart := s.Master.Start
+	loc := dtstart.Location()
+
+	var starts []time.Time
+	if !dtstart.Before(from) && !dtstart.After(to) {
+		starts = append(starts, dtstart)
+	}
+	for _, d := range s.Dates {
+		if !d.Before(from) && !d.After(to) {
+			starts = append(starts, d.In(loc))
+		}
+	}
+
+	// Rules run on wall-clock time and are placed in the event's zone
+	// afterwards, so DST changes don't shift the time of day. A day of
+	// margin covers the difference between wall-clock and real bounds.
+	for _, rule := range s.Rules {
+		r, err := parseRule(rule, dtstart, s.Master.IsAllDay)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range r.Between(floating(from, loc).AddDate(0, 0, -1), floating(to, loc).AddDate(0, 0, 1), true) {
+			if start := localize(t, loc); !start.Before(from) && !start.After(to) {
+				starts = append(starts, start)
+			}
+		}
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	var result []time.Time
+	for _, start := range starts {
+		if len(result) > 0 && result[len(result)-1].Equal(start) {
+			continue
+		}
+		if isExcluded(s.Exclusions, start) {
+			continue
+		}
+		result = append(result, start)
+	}
+	return result, nil
+}
+
+// parseRule parses an RRULE value for an event starting at dtstart.
+func parseRule(rule string, dtstart time.Time, isAllDay bool) (*rrule.RRule, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+
+	opt, err := rrule.StrToROptionInLocation(rule, dtstart.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE %q: %w", rule, err)
+	}
+
+	// A date-only UNTIL on a timed event includes that whole day
+	if !isAllDay && !opt.Until.IsZero() && untilIsDate(rule) {
+		opt.Until = opt.Until.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	loc := dtstart.Location()
+	if !opt.Until.IsZero() {
+		opt.Until = floating(opt.Until, loc)
+	}
+	opt.Dtstart = floating(dtstart, loc)
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE %q: %w", rule, err)
+	}
+	return r, nil
+}
+
+func untilIsDate(rule string) bool {
+	for _, part := range strings.Split(rule, ";") {
+		if value, ok := strings.CutPrefix(strings.ToUpper(part), "UNTIL="); ok {
+			return len(value) == len("20060102")
+		}
+	}
+	return false
+}
+
+// floating returns t's wall-clock time in loc, as if it were UTC.
+func floating(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// localize places a wall-clock time from floating in loc. A time that
+// doesn't exist there (skipped by a DST change) moves forward by the gap,
+// as RFC 5545 requires.
+func localize(t time.Time, loc *time.Location) time.Time {
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+	if local.Hour() == t.Hour() && local.Minute() == t.Minute() {
+		return local
+	}
+
+	// In a gap, time.Date may use either offset. The one in effect before
+	// the gap is the smaller one, so it gives the later instant.
+	_, offset := local.Zone()
+	other := t.Add(-time.Duration(offset) * time.Second).In(loc)
+	if other.After(local) {
+		return other
+	}
+	return local
+}
+
+// instance builds the occurrence of the master starting at start.
+func instance(master core.Event, start time.Time) core.Event {
+	event := master
+	event.Start = start
+	if master.IsAllDay {
+		event.End = start.AddDate(0, 0, allDayLength(master))
+	} else {
+		event.End = start.Add(master.Duration())
+	}
+
+	event.ID = InstanceID(master.ID, start)
+	event.RecurringEventID = master.ID
+	return event
+}
+
+// allDayLength returns the length of an all-day event in days. Counting
+// calendar days keeps it right across DST changes, where a day isn't 24h.
+func allDayLength(event core.Event) int {
+	start := event.Start
+	end := event.End.In(start.Location())
+	days := 0
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		days++
+	}
+	return days
+}
+
+// overlaps reports whether an event overlaps [start, end).
+func overlaps(event core.Event, start, end time.Time) bool {
+	return event.Start.Before(end) && (event.End.After(start) || event.Start.Equal(start))
+}
+
+func isExcluded(exclusions []time.Time, t time.Time) bool {
+	for _, ex := range exclusions {
+		if ex.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,813 @@
+package recurrence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+// at parses "2006-01-02 15:04" in loc.
+func at(loc *time.Location, s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// day parses "2006-01-02" as midnight in loc.
+func day(loc *time.Location, s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02", s, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func timed(start time.Time, d time.Duration) core.Event {
+	return core.Event{ID: "ev1", DedupeKey: "uid1", Title: "Meeting", Start: start, End: start.Add(d)}
+}
+
+func allDay(start time.Time, days int) core.Event {
+	return core.Event{ID: "ev1", DedupeKey: "uid1", Title: "Trip", Start: start, End: start.AddDate(0, 0, days), IsAllDay: true}
+}
+
+func window(start, end time.Time) core.FetchOptions {
+	return core.FetchOptions{Start: start, End: end}
+}
+
+// formatStarts renders instance starts in their own location, with the
+// UTC offset so DST handling is visible.
+func formatStarts(events []core.Event) []string {
+	var result []string
+	for _, e := range events {
+		result = append(result, e.Start.Format("2006-01-02 15:04 -0700"))
+	}
+	return result
+}
+
+func TestExpand(t *testing.T) {
+	utc := time.UTC
+	ny := mustLoad(t, "America/New_York")
+	berlin := mustLoad(t, "Europe/Berlin")
+
+	tests := []struct {
+		name   string
+		series Series
+		opts   core.FetchOptions
+		want   []string
+	}{
+		{
+			name: "daily with count",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;COUNT=3"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-03 09:00 +0000",
+				"2026-03-04 09:00 +0000",
+			},
+		},
+		{
+			name: "unbounded rule is cut to the window",
+			series: Series{
+				Master: timed(at(utc, "2020-01-01 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY"},
+			},
+			opts: window(day(utc, "2026-03-10"), day(utc, "2026-03-12")),
+			want: []string{
+				"2026-03-10 09:00 +0000",
+				"2026-03-11 09:00 +0000",
+			},
+		},
+		{
+			name: "window before the series starts",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY"},
+			},
+			opts: window(day(utc, "2026-02-01"), day(utc, "2026-03-01")),
+			want: nil,
+		},
+		{
+			name: "weekly on several days",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 10:00"), 30*time.Minute),
+				Rules:  []string{"FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+			},
+			opts: window(day(utc, "2026-03-02"), day(utc, "2026-03-09")),
+			want: []string{
+				"2026-03-02 10:00 +0000",
+				"2026-03-04 10:00 +0000",
+				"2026-03-06 10:00 +0000",
+			},
+		},
+		{
+			name: "every other week",
+			series: Series{
+				Master: timed(at(utc, "2026-03-03 14:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY;INTERVAL=2"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-03 14:00 +0000",
+				"2026-03-17 14:00 +0000",
+				"2026-03-31 14:00 +0000",
+			},
+		},
+		{
+			name: "last friday of the month",
+			series: Series{
+				Master: timed(at(utc, "2026-01-30 16:00"), time.Hour),
+				Rules:  []string{"FREQ=MONTHLY;BYDAY=-1FR"},
+			},
+			opts: window(day(utc, "2026-01-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-01-30 16:00 +0000",
+				"2026-02-27 16:00 +0000",
+				"2026-03-27 16:00 +0000",
+			},
+		},
+		{
+			name: "monthly on the 31st skips short months",
+			series: Series{
+				Master: timed(at(utc, "2026-01-31 12:00"), time.Hour),
+				Rules:  []string{"FREQ=MONTHLY"},
+			},
+			opts: window(day(utc, "2026-01-01"), day(utc, "2026-06-01")),
+			want: []string{
+				"2026-01-31 12:00 +0000",
+				"2026-03-31 12:00 +0000",
+				"2026-05-31 12:00 +0000",
+			},
+		},
+		{
+			name: "second tuesday via BYSETPOS",
+			series: Series{
+				Master: timed(at(utc, "2026-03-10 09:00"), time.Hour),
+				Rules:  []string{"FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-06-01")),
+			want: []string{
+				"2026-03-10 09:00 +0000",
+				"2026-04-14 09:00 +0000",
+				"2026-05-12 09:00 +0000",
+			},
+		},
+		{
+			name: "yearly on leap day",
+			series: Series{
+				Master: allDay(day(utc, "2024-02-29"), 1),
+				Rules:  []string{"FREQ=YEARLY"},
+			},
+			opts: window(day(utc, "2024-01-01"), day(utc, "2033-01-01")),
+			want: []string{
+				"2024-02-29 00:00 +0000",
+				"2028-02-29 00:00 +0000",
+				"2032-02-29 00:00 +0000",
+			},
+		},
+		{
+			name: "until is inclusive",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;UNTIL=20260304T090000Z"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-03 09:00 +0000",
+				"2026-03-04 09:00 +0000",
+			},
+		},
+		{
+			name: "date-only until on a timed event includes that day",
+			series: Series{
+				Master: timed(at(berlin, "2026-03-02 18:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;UNTIL=20260304"},
+			},
+			opts: window(day(berlin, "2026-03-01"), day(berlin, "2026-04-01")),
+			want: []string{
+				"2026-03-02 18:00 +0100",
+				"2026-03-03 18:00 +0100",
+				"2026-03-04 18:00 +0100",
+			},
+		},
+		{
+			name: "wall-clock time kept across spring forward",
+			series: Series{
+				Master: timed(at(ny, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY"},
+			},
+			opts: window(day(ny, "2026-03-01"), day(ny, "2026-03-17")),
+			want: []string{
+				"2026-03-02 09:00 -0500",
+				"2026-03-09 09:00 -0400",
+				"2026-03-16 09:00 -0400",
+			},
+		},
+		{
+			name: "wall-clock time kept across fall back",
+			series: Series{
+				Master: timed(at(berlin, "2026-10-23 08:30"), 15*time.Minute),
+				Rules:  []string{"FREQ=DAILY;COUNT=4"},
+			},
+			opts: window(day(berlin, "2026-10-01"), day(berlin, "2026-11-01")),
+			want: []string{
+				"2026-10-23 08:30 +0200",
+				"2026-10-24 08:30 +0200",
+				"2026-10-25 08:30 +0100",
+				"2026-10-26 08:30 +0100",
+			},
+		},
+		{
+			name: "nonexistent local time moves forward",
+			series: Series{
+				Master: timed(at(ny, "2026-03-07 02:30"), 30*time.Minute),
+				Rules:  []string{"FREQ=DAILY;COUNT=3"},
+			},
+			opts: window(day(ny, "2026-03-01"), day(ny, "2026-04-01")),
+			want: []string{
+				"2026-03-07 02:30 -0500",
+				"2026-03-08 03:30 -0400",
+				"2026-03-09 02:30 -0400",
+			},
+		},
+		{
+			name: "rules in a zone other than the window's",
+			series: Series{
+				Master: timed(at(berlin, "2026-03-02 23:30"), time.Hour),
+				Rules:  []string{"FREQ=DAILY"},
+			},
+			opts: window(at(utc, "2026-03-04 00:00"), at(utc, "2026-03-05 00:00")),
+			want: []string{
+				"2026-03-04 23:30 +0100",
+			},
+		},
+		{
+			name: "all-day events stay on midnight across DST",
+			series: Series{
+				Master: allDay(day(berlin, "2026-03-27"), 1),
+				Rules:  []string{"FREQ=DAILY;COUNT=3"},
+			},
+			opts: window(day(berlin, "2026-03-01"), day(berlin, "2026-04-01")),
+			want: []string{
+				"2026-03-27 00:00 +0100",
+				"2026-03-28 00:00 +0100",
+				"2026-03-29 00:00 +0100",
+			},
+		},
+		{
+			name: "exclusions",
+			series: Series{
+				Master:     timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:      []string{"FREQ=DAILY;COUNT=4"},
+				Exclusions: []time.Time{at(utc, "2026-03-03 09:00")},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-04 09:00 +0000",
+				"2026-03-05 09:00 +0000",
+			},
+		},
+		{
+			name: "exclusion given in another zone",
+			series: Series{
+				Master:     timed(at(berlin, "2026-03-02 09:00"), time.Hour),
+				Rules:      []string{"FREQ=DAILY;COUNT=2"},
+				Exclusions: []time.Time{at(utc, "2026-03-03 08:00")},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0100",
+			},
+		},
+		{
+			name: "excluding the first occurrence",
+			series: Series{
+				Master:     timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:      []string{"FREQ=DAILY;COUNT=2"},
+				Exclusions: []time.Time{at(utc, "2026-03-02 09:00")},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-03 09:00 +0000",
+			},
+		},
+		{
+			name: "extra dates",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY;COUNT=2"},
+				Dates:  []time.Time{at(utc, "2026-03-05 15:00")},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-05 15:00 +0000",
+				"2026-03-09 09:00 +0000",
+			},
+		},
+		{
+			name: "dates without rules",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Dates:  []time.Time{at(utc, "2026-03-20 09:00"), at(utc, "2026-03-02 09:00")},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-20 09:00 +0000",
+			},
+		},
+		{
+			name: "several rules are merged",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY;BYDAY=MO,TH;COUNT=4", "FREQ=WEEKLY;BYDAY=MO;COUNT=2"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-05 09:00 +0000",
+				"2026-03-09 09:00 +0000",
+				"2026-03-12 09:00 +0000",
+			},
+		},
+		{
+			name: "dtstart counts even if the rule doesn't match it",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour), // a Monday
+				Rules:  []string{"FREQ=WEEKLY;BYDAY=TU;COUNT=2"},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-03 09:00 +0000",
+				"2026-03-10 09:00 +0000",
+			},
+		},
+		{
+			name: "occurrence running into the window",
+			series: Series{
+				Master: timed(at(utc, "2026-03-01 22:00"), 4*time.Hour),
+				Rules:  []string{"FREQ=DAILY"},
+			},
+			opts: window(day(utc, "2026-03-03"), day(utc, "2026-03-04")),
+			want: []string{
+				"2026-03-02 22:00 +0000",
+				"2026-03-03 22:00 +0000",
+			},
+		},
+		{
+			name: "multi-day all-day event running into the window",
+			series: Series{
+				Master: allDay(day(utc, "2026-03-06"), 3),
+				Rules:  []string{"FREQ=WEEKLY"},
+			},
+			opts: window(day(utc, "2026-03-15"), day(utc, "2026-03-16")),
+			want: []string{
+				"2026-03-13 00:00 +0000",
+			},
+		},
+		{
+			name: "occurrence ending at the window start is left out",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 23:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;COUNT=2"},
+			},
+			opts: window(day(utc, "2026-03-04"), day(utc, "2026-03-05")),
+			want: nil,
+		},
+		{
+			name: "overridden occurrence moves",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;COUNT=3"},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-03 09:00"),
+					Event:        timed(at(utc, "2026-03-03 13:00"), time.Hour),
+				}},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-03 13:00 +0000",
+				"2026-03-04 09:00 +0000",
+			},
+		},
+		{
+			name: "override moved into the window",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY;COUNT=3"},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-09 09:00"),
+					Event:        timed(at(utc, "2026-03-20 09:00"), time.Hour),
+				}},
+			},
+			opts: window(day(utc, "2026-03-19"), day(utc, "2026-03-21")),
+			want: []string{
+				"2026-03-20 09:00 +0000",
+			},
+		},
+		{
+			name: "override moved out of the window",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=WEEKLY;COUNT=3"},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-09 09:00"),
+					Event:        timed(at(utc, "2026-03-20 09:00"), time.Hour),
+				}},
+			},
+			opts: window(day(utc, "2026-03-09"), day(utc, "2026-03-10")),
+			want: nil,
+		},
+		{
+			name: "cancelled occurrence",
+			series: Series{
+				Master: timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;COUNT=3"},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-03 09:00"),
+					Cancelled:    true,
+				}},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+				"2026-03-04 09:00 +0000",
+			},
+		},
+		{
+			name: "exclusion wins over an override",
+			series: Series{
+				Master:     timed(at(utc, "2026-03-02 09:00"), time.Hour),
+				Rules:      []string{"FREQ=DAILY;COUNT=2"},
+				Exclusions: []time.Time{at(utc, "2026-03-03 09:00")},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-03 09:00"),
+					Event:        timed(at(utc, "2026-03-03 11:00"), time.Hour),
+				}},
+			},
+			opts: window(day(utc, "2026-03-01"), day(utc, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 +0000",
+			},
+		},
+		{
+			name: "override identified in another zone",
+			series: Series{
+				Master: timed(at(ny, "2026-03-02 09:00"), time.Hour),
+				Rules:  []string{"FREQ=DAILY;COUNT=2"},
+				Overrides: []Override{{
+					RecurrenceID: at(utc, "2026-03-03 14:00"),
+					Event:        timed(at(ny, "2026-03-03 10:00"), time.Hour),
+				}},
+			},
+			opts: window(day(ny, "2026-03-01"), day(ny, "2026-04-01")),
+			want: []string{
+				"2026-03-02 09:00 -0500",
+				"2026-03-03 10:00 -0500",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Expand(tt.series, tt.opts)
+			if err != nil {
+				t.Fatalf("Expand: %v", err)
+			}
+			got := formatStarts(events)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("instances:\n  got  %q\n  want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandInstanceFields(t *testing.T) {
+	berlin := mustLoad(t, "Europe/Berlin")
+	master := timed(at(berlin, "2026-03-23 10:00"), 90*time.Minute)
+	master.Location = "Room 1"
+	master.Status = core.StatusTentative
+
+	series := Series{
+		Master: master,
+		Rules:  []string{"FREQ=WEEKLY;COUNT=2"},
+		Overrides: []Override{{
+			RecurrenceID: at(berlin, "2026-03-30 10:00"),
+			Event: core.Event{
+				Title:  "Meeting (moved)",
+				Status: core.StatusAccepted,
+				Start:  at(berlin, "2026-03-30 11:00"),
+				End:    at(berlin, "2026-03-30 12:00"),
+			},
+		}},
+	}
+
+	events, err := Expand(series, window(day(berlin, "2026-03-01"), day(berlin, "2026-04-30")))
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d instances, want 2", len(events))
+	}
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"first ID", events[0].ID, "ev1_20260323T090000Z"},
+		{"first dedupe key", events[0].DedupeKey, "uid1"},
+		{"first recurring ID", events[0].RecurringEventID, "ev1"},
+		{"first duration", events[0].Duration(), 90 * time.Minute},
+		{"first keeps master fields", events[0].Location, "Room 1"},
+		{"first keeps master status", events[0].Status, core.StatusTentative},
+		// The override is identified by its original start, before DST began
+		{"override ID", events[1].ID, "ev1_20260330T080000Z"},
+		{"override dedupe key", events[1].DedupeKey, "uid1"},
+		{"override recurring ID", events[1].RecurringEventID, "ev1"},
+		{"override title", events[1].Title, "Meeting (moved)"},
+		{"override status", events[1].Status, core.StatusAccepted},
+		{"override duration", events[1].Duration(), time.Hour},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if series.Master.ID != "ev1" || series.Master.RecurringEventID != "" {
+		t.Error("Expand modified the master")
+	}
+}
+
+func TestExpandAllDayLength(t *testing.T) {
+	berlin := mustLoad(t, "Europe/Berlin")
+
+	tests := []struct {
+		name    string
+		master  core.Event
+		rule    string
+		wantEnd []string
+	}{
+		{
+			name:    "single day across DST",
+			master:  allDay(day(berlin, "2026-03-28"), 1),
+			rule:    "FREQ=DAILY;COUNT=3",
+			wantEnd: []string{"2026-03-29", "2026-03-30", "2026-03-31"},
+		},
+		{
+			name:    "three days",
+			master:  allDay(day(berlin, "2026-03-06"), 3),
+			rule:    "FREQ=WEEKLY;COUNT=2",
+			wantEnd: []string{"2026-03-09", "2026-03-16"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Expand(Series{Master: tt.master, Rules: []string{tt.rule}},
+				window(day(berlin, "2026-03-01"), day(berlin, "2026-04-30")))
+			if err != nil {
+				t.Fatalf("Expand: %v", err)
+			}
+			var got []string
+			for _, e := range events {
+				if !e.IsAllDay {
+					t.Errorf("instance %s is not all-day", e.ID)
+				}
+				if e.End.Hour() != 0 || e.End.Minute() != 0 {
+					t.Errorf("instance %s ends at %v, want midnight", e.ID, e.End)
+				}
+				got = append(got, e.End.Format("2006-01-02"))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantEnd, ",") {
+				t.Errorf("ends = %v, want %v", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	start := at(time.UTC, "2026-03-02 09:00")
+
+	tests := []struct {
+		name   string
+		series Series
+		opts   core.FetchOptions
+	}{
+		{
+			name:   "invalid rule",
+			series: Series{Master: timed(start, time.Hour), Rules: []string{"FREQ=SOMETIMES"}},
+			opts:   window(start, start.AddDate(0, 1, 0)),
+		},
+		{
+			name:   "rule without frequency",
+			series: Series{Master: timed(start, time.Hour), Rules: []string{"COUNT=3"}},
+			opts:   window(start, start.AddDate(0, 1, 0)),
+		},
+		{
+			name:   "window without end",
+			series: Series{Master: timed(start, time.Hour), Rules: []string{"FREQ=DAILY"}},
+			opts:   core.FetchOptions{Start: start},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Expand(tt.series, tt.opts); err == nil {
+				t.Error("Expand succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestInstanceID(t *testing.T) {
+	berlin := mustLoad(t, "Europe/Berlin")
+
+	tests := []struct {
+		id         string
+		wantMaster string
+		wantTime   time.Time
+	}{
+		{InstanceID("abc", at(berlin, "2026-03-02 09:00")), "abc", at(time.UTC, "2026-03-02 08:00")},
+		{InstanceID("a_b@example.com", at(time.UTC, "2026-07-01 00:00")), "a_b@example.com", at(time.UTC, "2026-07-01 00:00")},
+		{"plain-id", "plain-id", time.Time{}},
+		{"with_underscore", "with_underscore", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		master, rid := SplitID(tt.id)
+		if master != tt.wantMaster || !rid.Equal(tt.wantTime) {
+			t.Errorf("SplitID(%q) = %q, %v; want %q, %v", tt.id, master, rid, tt.wantMaster, tt.wantTime)
+		}
+	}
+}
+
+func TestMoveSeries(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name      string
+		master    core.Event
+		instance  core.Event
+		start     time.Time
+		end       time.Time
+		isAllDay  bool
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "later the same day",
+			master:    timed(at(ny, "2026-01-05 10:00"), time.Hour),
+			instance:  timed(at(ny, "2026-01-19 10:00"), time.Hour),
+			start:     at(ny, "2026-01-19 11:30"),
+			end:       at(ny, "2026-01-19 12:00"),
+			wantStart: at(ny, "2026-01-05 11:30"),
+			wantEnd:   at(ny, "2026-01-05 12:00"),
+		},
+		{
+			name:      "next day, UTC instance",
+			master:    timed(at(ny, "2026-01-05 10:00"), time.Hour),
+			instance:  timed(at(ny, "2026-01-19 10:00").UTC(), time.Hour),
+			start:     at(ny, "2026-01-20 10:00"),
+			end:       at(ny, "2026-01-20 11:00"),
+			wantStart: at(ny, "2026-01-06 10:00"),
+			wantEnd:   at(ny, "2026-01-06 11:00"),
+		},
+		{
+			name:      "all-day series",
+			master:    allDay(day(time.UTC, "2026-01-05"), 1),
+			instance:  allDay(day(time.UTC, "2026-01-19"), 1),
+			start:     day(ny, "2026-01-21"),
+			end:       day(ny, "2026-01-23"),
+			isAllDay:  true,
+			wantStart: day(ny, "2026-01-07"),
+			wantEnd:   day(ny, "2026-01-09"),
+		},
+		{
+			name:      "all-day series becomes timed",
+			master:    allDay(day(time.UTC, "2026-01-05"), 1),
+			instance:  allDay(day(time.UTC, "2026-01-19"), 1),
+			start:     at(ny, "2026-01-19 09:00"),
+			end:       at(ny, "2026-01-19 09:30"),
+			wantStart: at(ny, "2026-01-05 09:00"),
+			wantEnd:   at(ny, "2026-01-05 09:30"),
+		},
+		{
+			name:      "timed series becomes all-day",
+			master:    timed(at(ny, "2026-01-05 22:00"), time.Hour),
+			instance:  timed(at(ny, "2026-01-19 22:00"), time.Hour),
+			start:     day(ny, "2026-01-19"),
+			end:       day(ny, "2026-01-20"),
+			isAllDay:  true,
+			wantStart: day(ny, "2026-01-05"),
+			wantEnd:   day(ny, "2026-01-06"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := MoveSeries(tt.master, tt.instance, tt.start, tt.end, tt.isAllDay)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("MoveSeries() = %v - %v, want %v - %v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestSplitRules(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name       string
+		rules      []string
+		dtstart    time.Time
+		isAllDay   bool
+		at         time.Time
+		wantBefore []string
+		wantAfter  []string
+		wantErr    bool
+	}{
+		{
+			name:       "open-ended weekly",
+			rules:      []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"},
+			dtstart:    at(ny, "2026-01-05 10:00"),
+			at:         at(ny, "2026-02-02 10:00"),
+			wantBefore: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO;UNTIL=20260202T145959Z"},
+			wantAfter:  []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"},
+		},
+		{
+			name:       "count is shared out",
+			rules:      []string{"FREQ=DAILY;COUNT=10"},
+			dtstart:    at(ny, "2026-01-05 10:00"),
+			at:         at(ny, "2026-01-09 10:00"),
+			wantBefore: []string{"FREQ=DAILY;UNTIL=20260109T145959Z"},
+			wantAfter:  []string{"FREQ=DAILY;COUNT=6"},
+		},
+		{
+			name:       "until is kept for the new series",
+			rules:      []string{"RRULE:FREQ=DAILY;UNTIL=20260131T150000Z", "EXDATE;TZID=America/New_York:20260107T100000"},
+			dtstart:    at(ny, "2026-01-05 10:00"),
+			at:         at(ny, "2026-01-20 10:00"),
+			wantBefore: []string{"RRULE:FREQ=DAILY;UNTIL=20260120T145959Z", "EXDATE;TZID=America/New_York:20260107T100000"},
+			wantAfter:  []string{"RRULE:FREQ=DAILY;UNTIL=20260131T150000Z", "EXDATE;TZID=America/New_York:20260107T100000"},
+		},
+		{
+			name:       "all-day",
+			rules:      []string{"RRULE:FREQ=MONTHLY;COUNT=12"},
+			dtstart:    day(time.UTC, "2026-01-15"),
+			isAllDay:   true,
+			at:         day(time.UTC, "2026-04-15"),
+			wantBefore: []string{"RRULE:FREQ=MONTHLY;UNTIL=20260414"},
+			wantAfter:  []string{"RRULE:FREQ=MONTHLY;COUNT=9"},
+		},
+		{
+			name:    "past the end",
+			rules:   []string{"FREQ=DAILY;COUNT=3"},
+			dtstart: at(ny, "2026-01-05 10:00"),
+			at:      at(ny, "2026-01-09 10:00"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, after, err := SplitRules(tt.rules, tt.dtstart, tt.isAllDay, tt.at)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("SplitRules() = %v, %v; want error", before, after)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplitRules() error: %v", err)
+			}
+			if strings.Join(before, "\n") != strings.Join(tt.wantBefore, "\n") {
+				t.Errorf("before = %q, want %q", before, tt.wantBefore)
+			}
+			if strings.Join(after, "\n") != strings.Join(tt.wantAfter, "\n") {
+				t.Errorf("after = %q, want %q", after, tt.wantAfter)
+			}
+		})
+	}
+}
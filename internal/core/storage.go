@@ -12,15 +12,24 @@ type Storage interface {
 	SyncEvents(ctx context.Context, events []Event) error
 	// List returns events sorted by Start time.
 	ListEvents(ctx context.Context, filter EventFilter) ([]Event, error)
+	// DeleteEvents removes all events matching the filter.
+	// Used to drop stale events before re-syncing a time window.
+	DeleteEvents(ctx context.Context, filter EventFilter) error
 	// Purge removes events associated with a specific provider ID.
 	// Useful when re-syncing a calendar from scratch.
 	PurgeProvider(ctx context.Context, providerID string) error
 }
 
 // EventFilter defines criteria for querying the database.
+// Events match when they overlap the Start/End window; a zero
+// Start or End leaves that side of the window open.
 type EventFilter struct {
 	Start time.Time
 	End   time.Time
 	// If empty, return all providers
 	ProviderIDs []string
+	// If empty, return all calendars
+	CalendarIDs []string
+	// If empty, return all events
+	EventIDs []string
 }
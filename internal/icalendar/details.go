@@ -0,0 +1,141 @@
+package icalendar
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// Properties some clients use to store the video call link
+var meetingLinkProps = []string{
+	"X-GOOGLE-CONFERENCE",
+	"X-MICROSOFT-SKYPETEAMSMEETINGURL",
+	"X-MICROSOFT-ONLINEMEETINGCONFLINK",
+}
+
+// Hosts of video conferencing services, matched as domain suffixes
+var meetingHosts = []string{
+	"meet.google.com",
+	"zoom.us",
+	"teams.microsoft.com",
+	"teams.live.com",
+	"webex.com",
+	"whereby.com",
+	"meet.jit.si",
+	"gotomeeting.com",
+	"meet.goto.com",
+	"chime.aws",
+}
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// eventType maps Outlook's busy status and well-known categories to an
+// event type. Plain iCalendar has no notion of OOO or focus time.
+func eventType(vevent *ical.Component) core.EventType {
+	switch strings.ToUpper(propText(vevent, "X-MICROSOFT-CDO-BUSYSTATUS")) {
+	case "OOF":
+		return core.TypeOutOfOffice
+	case "WORKINGELSEWHERE":
+		return core.TypeWorkLocation
+	}
+
+	for _, prop := range vevent.Props.Values(ical.PropCategories) {
+		categories, err := prop.TextList()
+		if err != nil {
+			continue
+		}
+		for _, cat := range categories {
+			switch strings.ToLower(strings.TrimSpace(cat)) {
+			case "focus time", "focustime":
+				return core.TypeFocusTime
+			case "out of office", "ooo":
+				return core.TypeOutOfOffice
+			}
+		}
+	}
+
+	return core.TypeDefault
+}
+
+// meetingLink finds the video call link: a CONFERENCE property (RFC 7986),
+// a vendor property, or a known meeting URL in the URL, location or
+// description.
+func meetingLink(vevent *ical.Component) string {
+	for _, prop := range vevent.Props.Values(ical.PropConference) {
+		features := strings.ToUpper(prop.Params.Get(ical.ParamFeature))
+		if features == "" || strings.Contains(features, "VIDEO") {
+			if isWebURL(prop.Value) {
+				return prop.Value
+			}
+		}
+	}
+
+	for _, name := range meetingLinkProps {
+		if link := propText(vevent, name); isWebURL(link) {
+			return link
+		}
+	}
+
+	for _, name := range []string{ical.PropURL, ical.PropLocation, ical.PropDescription} {
+		for _, link := range urlPattern.FindAllString(propText(vevent, name), -1) {
+			link = strings.TrimRight(link, ".,;)>]")
+			if isMeetingURL(link) {
+				return link
+			}
+		}
+	}
+
+	return ""
+}
+
+// attachments returns the linked attachments. Inline (binary) attachments
+// are skipped; we only store links.
+func attachments(vevent *ical.Component) []core.Attachment {
+	var result []core.Attachment
+	for _, prop := range vevent.Props.Values(ical.PropAttach) {
+		if prop.ValueType() == ical.ValueBinary || !isWebURL(prop.Value) {
+			continue
+		}
+
+		name := prop.Params.Get("FILENAME")
+		if name == "" {
+			name = prop.Params.Get("X-FILENAME")
+		}
+		if name == "" {
+			if u, err := url.Parse(prop.Value); err == nil {
+				name = path.Base(u.Path)
+			}
+		}
+
+		result = append(result, core.Attachment{
+			ID:       prop.Value,
+			Name:     name,
+			URL:      prop.Value,
+			MimeType: prop.Params.Get(ical.ParamFormatType),
+		})
+	}
+	return result
+}
+
+func isWebURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+func isMeetingURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, h := range meetingHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
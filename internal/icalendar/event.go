@@ -0,0 +1,228 @@
+// Package icalendar converts iCalendar (RFC 5545) data to and from
+// core events. It is shared by the providers that speak iCalendar
+// (CalDAV, .ics files and feeds).
+package icalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+)
+
+// utcFormat is a DATE-TIME in UTC.
+const utcFormat = "20060102T150405Z"
+
+// floatingFormat is a DATE-TIME in local or TZID time.
+const floatingFormat = "20060102T150405"
+
+// ParseEvent converts a VEVENT into a core.Event.
+// ProviderID and Calendar are left for the caller to fill in. self holds
+// the user's email addresses, used to find their response among the
+// attendees. Time zones only defined in the calendar's VTIMEZONEs aren't
+// known here; Events resolves them.
+func ParseEvent(vevent *ical.Component, self []string) (core.Event, error) {
+	return parseEvent(vevent, self, nil)
+}
+
+// parseEvent is ParseEvent with the calendar's time zones.
+func parseEvent(vevent *ical.Component, self []string, z zones) (core.Event, error) {
+	uid := propText(vevent, ical.PropUID)
+	if uid == "" {
+		return core.Event{}, fmt.Errorf("event has no UID")
+	}
+
+	start, isAllDay, err := dateTime(vevent.Props.Get(ical.PropDateTimeStart), z)
+	if err != nil {
+		return core.Event{}, fmt.Errorf("event %s: invalid DTSTART: %w", uid, err)
+	}
+
+	end, err := endTime(vevent, start, isAllDay, z)
+	if err != nil {
+		return core.Event{}, fmt.Errorf("event %s: %w", uid, err)
+	}
+
+	event := core.Event{
+		ID:          uid,
+		DedupeKey:   uid,
+		Type:        eventType(vevent),
+		Title:       propText(vevent, ical.PropSummary),
+		Description: propText(vevent, ical.PropDescription),
+		Location:    propText(vevent, ical.PropLocation),
+		URL:         propText(vevent, ical.PropURL),
+		MeetingLink: meetingLink(vevent),
+		Organizer:   address(propText(vevent, ical.PropOrganizer)),
+		Attendees:   attendees(vevent),
+		Attachments: attachments(vevent),
+		Status:      participation(vevent, self),
+		Start:       start,
+		End:         end,
+		IsAllDay:    isAllDay,
+	}
+
+	if organizer := vevent.Props.Get(ical.PropOrganizer); organizer != nil {
+		event.OrganizerName = organizer.Params.Get(ical.ParamCommonName)
+	}
+
+	// Instances of a recurring event share the UID
+	if rid := vevent.Props.Get(ical.PropRecurrenceID); rid != nil {
+		ridTime, _, err := dateTime(rid, z)
+		if err != nil {
+			return core.Event{}, fmt.Errorf("event %s: invalid RECURRENCE-ID: %w", uid, err)
+		}
+		event.ID = recurrence.InstanceID(uid, ridTime)
+		event.RecurringEventID = uid
+	}
+
+	return event, nil
+}
+
+// IsCancelled reports whether the event was cancelled by its organizer.
+func IsCancelled(vevent *ical.Component) bool {
+	return strings.EqualFold(propText(vevent, ical.PropStatus), "CANCELLED")
+}
+
+// participation determines the user's response status for an event.
+func participation(vevent *ical.Component, self []string) core.EventStatus {
+	if isSelf(address(propText(vevent, ical.PropOrganizer)), self) {
+		return core.StatusAccepted
+	}
+
+	for _, attendee := range vevent.Props.Values(ical.PropAttendee) {
+		if !isSelf(address(attendee.Value), self) {
+			continue
+		}
+		switch strings.ToUpper(attendee.Params.Get(ical.ParamParticipationStatus)) {
+		case "ACCEPTED":
+			return core.StatusAccepted
+		case "DECLINED":
+			return core.StatusRejected
+		case "TENTATIVE":
+			return core.StatusTentative
+		default: // NEEDS-ACTION is the default PARTSTAT
+			return core.StatusAwaiting
+		}
+	}
+
+	// Not invited: own event, subscribed calendar or imported event
+	return core.StatusNoResponse
+}
+
+// attendees lists the ATTENDEE properties of an event.
+func attendees(vevent *ical.Component) []core.Attendee {
+	var result []core.Attendee
+	for _, prop := range vevent.Props.Values(ical.PropAttendee) {
+		attendee := core.Attendee{
+			Name:     prop.Params.Get(ical.ParamCommonName),
+			Email:    address(prop.Value),
+			Optional: strings.EqualFold(prop.Params.Get(ical.ParamRole), "OPT-PARTICIPANT"),
+			Response: core.StatusAwaiting,
+		}
+		switch strings.ToUpper(prop.Params.Get(ical.ParamCalendarUserType)) {
+		case "RESOURCE", "ROOM":
+			attendee.IsResource = true
+		}
+		switch strings.ToUpper(prop.Params.Get(ical.ParamParticipationStatus)) {
+		case "ACCEPTED":
+			attendee.Response = core.StatusAccepted
+		case "DECLINED":
+			attendee.Response = core.StatusRejected
+		case "TENTATIVE":
+			attendee.Response = core.StatusTentative
+		}
+		result = append(result, attendee)
+	}
+	return result
+}
+
+// endTime returns DTEND, or DTSTART + DURATION, or the RFC 5545 default
+// (one day for all-day events, zero length otherwise).
+func endTime(vevent *ical.Component, start time.Time, isAllDay bool, z zones) (time.Time, error) {
+	if prop := vevent.Props.Get(ical.PropDateTimeEnd); prop != nil {
+		end, _, err := dateTime(prop, z)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTEND: %w", err)
+		}
+		return end, nil
+	}
+
+	if prop := vevent.Props.Get(ical.PropDuration); prop != nil {
+		d, err := prop.Duration()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		return start.Add(d), nil
+	}
+
+	if isAllDay {
+		return start.AddDate(0, 0, 1), nil
+	}
+	return start, nil
+}
+
+// dateTime parses a DATE or DATE-TIME property. Dates and floating times
+// are taken as local time. A TZID is resolved with z (see zones.location);
+// one that is neither a known zone name nor defined in the calendar, which
+// RFC 5545 doesn't allow, is taken as local time too.
+func dateTime(prop *ical.Prop, z zones) (t time.Time, isDate bool, err error) {
+	if prop == nil {
+		return time.Time{}, false, fmt.Errorf("missing value")
+	}
+
+	isDate = prop.ValueType() == ical.ValueDate || len(prop.Value) == len("20060102")
+
+	loc := time.Local
+	if tzid := prop.Params.Get(ical.PropTimezoneID); tzid != "" {
+		if l, ok := z.location(tzid); ok {
+			loc = l
+		}
+		// Parse in loc rather than having go-ical look the TZID up again
+		floating := *prop
+		floating.Params = ical.Params{}
+		for k, v := range prop.Params {
+			if k != ical.PropTimezoneID {
+				floating.Params[k] = v
+			}
+		}
+		prop = &floating
+	}
+
+	t, err = prop.DateTime(loc)
+	return t, isDate, err
+}
+
+// propText returns a text property's unescaped value, or "".
+func propText(comp *ical.Component, name string) string {
+	prop := comp.Props.Get(name)
+	if prop == nil {
+		return ""
+	}
+	if text, err := prop.Text(); err == nil {
+		return text
+	}
+	return prop.Value
+}
+
+// address strips the mailto: scheme from a calendar user address.
+func address(value string) string {
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		return value[len("mailto:"):]
+	}
+	return value
+}
+
+func isSelf(addr string, self []string) bool {
+	if addr == "" {
+		return false
+	}
+	for _, s := range self {
+		if strings.EqualFold(addr, s) {
+			return true
+		}
+	}
+	return false
+}
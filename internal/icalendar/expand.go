@@ -0,0 +1,112 @@
+package icalendar
+
+import (
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+)
+
+// Events returns the events in cal that overlap the window of opts, with
+// recurring events expanded into instances. Cancelled and malformed
+// events are left out. Overrides without their master (as returned by a
+// server that expands recurrences itself) are passed through as they are.
+func Events(cal *ical.Calendar, self []string, opts core.FetchOptions) []core.Event {
+	var results []core.Event
+	z := calendarZones(cal)
+
+	for _, comp := range cal.Children {
+		if comp.Name != ical.CompEvent {
+			continue
+		}
+		uid := propText(comp, ical.PropUID)
+
+		if comp.Props.Get(ical.PropRecurrenceID) != nil {
+			if Master(cal, uid) != nil || IsCancelled(comp) {
+				continue // part of the master's series
+			}
+			if event, err := parseEvent(comp, self, z); err == nil {
+				results = append(results, event)
+			}
+			continue
+		}
+
+		if IsCancelled(comp) {
+			continue
+		}
+		event, err := parseEvent(comp, self, z)
+		if err != nil {
+			continue // skip malformed events
+		}
+
+		if !isRecurring(comp) {
+			results = append(results, event)
+			continue
+		}
+
+		instances, err := recurrence.Expand(series(cal, comp, event, self, z), opts)
+		if err != nil {
+			continue // skip events with broken rules
+		}
+		results = append(results, instances...)
+	}
+
+	return results
+}
+
+func isRecurring(vevent *ical.Component) bool {
+	return vevent.Props.Get(ical.PropRecurrenceRule) != nil || vevent.Props.Get(ical.PropRecurrenceDates) != nil
+}
+
+// series collects the rules and exceptions of a recurring master.
+func series(cal *ical.Calendar, master *ical.Component, event core.Event, self []string, z zones) recurrence.Series {
+	s := recurrence.Series{
+		Master:     event,
+		Dates:      dateList(master.Props.Values(ical.PropRecurrenceDates), z),
+		Exclusions: dateList(master.Props.Values(ical.PropExceptionDates), z),
+	}
+	for _, rule := range master.Props.Values(ical.PropRecurrenceRule) {
+		s.Rules = append(s.Rules, rule.Value)
+	}
+
+	for _, comp := range Overrides(cal, event.ID) {
+		rid, _, err := dateTime(comp.Props.Get(ical.PropRecurrenceID), z)
+		if err != nil {
+			continue
+		}
+		if IsCancelled(comp) {
+			s.Overrides = append(s.Overrides, recurrence.Override{RecurrenceID: rid, Cancelled: true})
+			continue
+		}
+		override, err := parseEvent(comp, self, z)
+		if err != nil {
+			continue
+		}
+		s.Overrides = append(s.Overrides, recurrence.Override{RecurrenceID: rid, Event: override})
+	}
+
+	return s
+}
+
+// dateList parses RDATE or EXDATE properties, which may hold several
+// comma-separated values each. Periods count by their start.
+func dateList(props []ical.Prop, z zones) []time.Time {
+	var result []time.Time
+	for _, prop := range props {
+		for _, value := range strings.Split(prop.Value, ",") {
+			single := prop
+			single.Value, _, _ = strings.Cut(value, "/")
+			if single.ValueType() == ical.ValuePeriod {
+				single.Params = copyProp(prop).Params
+				single.Params.Del(ical.ParamValue)
+			}
+			if t, _, err := dateTime(&single, z); err == nil {
+				result = append(result, t)
+			}
+		}
+	}
+	return result
+}
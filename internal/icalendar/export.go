@@ -0,0 +1,177 @@
+package icalendar
+
+import (
+	"io"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+)
+
+// productID identifies tsk as the creator of exported calendars.
+const productID = "-//tsk//tsk//EN"
+
+// Export writes events as an iCalendar object with one VEVENT each.
+// Only the instances of recurring events that are in events are known, so
+// they are written as overrides (UID plus RECURRENCE-ID) without a master.
+// Events sharing a UID and RECURRENCE-ID are written once; n is the
+// number of events written.
+func Export(w io.Writer, events []core.Event, now time.Time) (n int, err error) {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText(ical.PropProductID, productID)
+
+	seen := make(map[string]bool)
+	for _, e := range events {
+		vevent := exportEvent(e, now)
+		key := propText(vevent, ical.PropUID)
+		if rid := vevent.Props.Get(ical.PropRecurrenceID); rid != nil {
+			key += "/" + rid.Value
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		cal.Children = append(cal.Children, vevent)
+	}
+
+	if err := ical.NewEncoder(w).Encode(cal); err != nil {
+		return 0, err
+	}
+	return len(cal.Children), nil
+}
+
+// exportEvent converts an event to a VEVENT. Times are written in UTC,
+// all-day events as dates.
+func exportEvent(e core.Event, now time.Time) *ical.Component {
+	vevent := ical.NewComponent(ical.CompEvent)
+
+	uid := e.DedupeKey
+	if uid == "" {
+		uid = e.ID
+	}
+	if e.RecurringEventID != "" {
+		// Instance IDs built by InstanceID (and Google's) carry the original
+		// start, which differs from Start when the instance was moved
+		_, rid := recurrence.SplitID(e.ID)
+		if rid.IsZero() {
+			rid = e.Start
+		}
+		setTime(vevent, ical.PropRecurrenceID, rid, e.IsAllDay)
+	}
+
+	vevent.Props.SetText(ical.PropUID, uid)
+	vevent.Props.SetDateTime(ical.PropDateTimeStamp, now.UTC())
+	setTime(vevent, ical.PropDateTimeStart, e.Start, e.IsAllDay)
+	setTime(vevent, ical.PropDateTimeEnd, e.End, e.IsAllDay)
+	vevent.Props.SetText(ical.PropSummary, e.Title)
+
+	if e.Description != "" {
+		vevent.Props.SetText(ical.PropDescription, e.Description)
+	}
+	if e.Location != "" {
+		vevent.Props.SetText(ical.PropLocation, e.Location)
+	}
+	if isWebURL(e.URL) {
+		prop := ical.NewProp(ical.PropURL)
+		prop.SetValueType(ical.ValueURI)
+		prop.Value = e.URL
+		vevent.Props.Set(prop)
+	}
+	if isWebURL(e.MeetingLink) {
+		prop := ical.NewProp(ical.PropConference)
+		// RFC 7986 requires VALUE=URI even though it's the default
+		prop.Params.Set(ical.ParamValue, "URI")
+		prop.Params.Set(ical.ParamFeature, "VIDEO")
+		prop.Value = e.MeetingLink
+		vevent.Props.Add(prop)
+	}
+
+	switch e.Type {
+	case core.TypeOutOfOffice:
+		setVendor(vevent, "X-MICROSOFT-CDO-BUSYSTATUS", "OOF")
+	case core.TypeWorkLocation:
+		setVendor(vevent, "X-MICROSOFT-CDO-BUSYSTATUS", "WORKINGELSEWHERE")
+		vevent.Props.SetText(ical.PropTransparency, "TRANSPARENT")
+	case core.TypeFocusTime:
+		vevent.Props.SetText(ical.PropCategories, "Focus time")
+	}
+
+	if e.Organizer != "" {
+		prop := ical.NewProp(ical.PropOrganizer)
+		prop.Value = "mailto:" + e.Organizer
+		if e.OrganizerName != "" {
+			prop.Params.Set(ical.ParamCommonName, e.OrganizerName)
+		}
+		vevent.Props.Add(prop)
+	}
+	for _, a := range e.Attendees {
+		if a.Email != "" {
+			vevent.Props.Add(exportAttendee(a))
+		}
+	}
+
+	for _, a := range e.Attachments {
+		if !isWebURL(a.URL) {
+			continue
+		}
+		prop := ical.NewProp(ical.PropAttach)
+		prop.Value = a.URL
+		if a.MimeType != "" {
+			prop.Params.Set(ical.ParamFormatType, a.MimeType)
+		}
+		if a.Name != "" {
+			prop.Params.Set("FILENAME", a.Name)
+		}
+		vevent.Props.Add(prop)
+	}
+
+	return vevent
+}
+
+// exportAttendee converts an attendee to an ATTENDEE property.
+func exportAttendee(a core.Attendee) *ical.Prop {
+	prop := ical.NewProp(ical.PropAttendee)
+	prop.Value = "mailto:" + a.Email
+	if a.Name != "" {
+		prop.Params.Set(ical.ParamCommonName, a.Name)
+	}
+	if a.IsResource {
+		prop.Params.Set(ical.ParamCalendarUserType, "RESOURCE")
+	}
+	if a.Optional {
+		prop.Params.Set(ical.ParamRole, "OPT-PARTICIPANT")
+	} else {
+		prop.Params.Set(ical.ParamRole, "REQ-PARTICIPANT")
+	}
+
+	partStat := "NEEDS-ACTION"
+	switch a.Response {
+	case core.StatusAccepted:
+		partStat = "ACCEPTED"
+	case core.StatusRejected:
+		partStat = "DECLINED"
+	case core.StatusTentative:
+		partStat = "TENTATIVE"
+	}
+	prop.Params.Set(ical.ParamParticipationStatus, partStat)
+	return prop
+}
+
+// setTime sets a DATE (all-day) or UTC DATE-TIME property.
+func setTime(vevent *ical.Component, name string, t time.Time, isAllDay bool) {
+	if isAllDay {
+		vevent.Props.SetDate(name, t)
+		return
+	}
+	vevent.Props.SetDateTime(name, t.UTC())
+}
+
+// setVendor sets a vendor (X-) property as is, without a VALUE parameter.
+func setVendor(vevent *ical.Component, name, value string) {
+	prop := ical.NewProp(name)
+	prop.Value = value
+	vevent.Props.Set(prop)
+}
@@ -0,0 +1,197 @@
+package icalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/core/recurrence"
+)
+
+// Master returns the VEVENT with the given UID that isn't an override of a
+// single instance, or nil.
+func Master(cal *ical.Calendar, uid string) *ical.Component {
+	for _, comp := range cal.Children {
+		if comp.Name == ical.CompEvent && propText(comp, ical.PropUID) == uid &&
+			comp.Props.Get(ical.PropRecurrenceID) == nil {
+			return comp
+		}
+	}
+	return nil
+}
+
+// Overrides returns the VEVENTs overriding single instances of the
+// recurring event with the given UID.
+func Overrides(cal *ical.Calendar, uid string) []*ical.Component {
+	var result []*ical.Component
+	for _, comp := range cal.Children {
+		if comp.Name == ical.CompEvent && propText(comp, ical.PropUID) == uid &&
+			comp.Props.Get(ical.PropRecurrenceID) != nil {
+			result = append(result, comp)
+		}
+	}
+	return result
+}
+
+// Instance returns the VEVENT for one instance of a recurring event,
+// adding an override copied from the master when the instance has none yet.
+func Instance(cal *ical.Calendar, uid string, recurrenceID time.Time) (*ical.Component, error) {
+	z := calendarZones(cal)
+	for _, comp := range Overrides(cal, uid) {
+		rid, _, err := dateTime(comp.Props.Get(ical.PropRecurrenceID), z)
+		if err == nil && rid.Equal(recurrenceID) {
+			return comp, nil
+		}
+	}
+
+	master := Master(cal, uid)
+	if master == nil {
+		return nil, fmt.Errorf("event %s not found", uid)
+	}
+
+	start, _, err := dateTime(master.Props.Get(ical.PropDateTimeStart), z)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: invalid DTSTART: %w", uid, err)
+	}
+	end, err := endTime(master, start, false, z)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %w", uid, err)
+	}
+
+	override := ical.NewComponent(ical.CompEvent)
+	for name, props := range master.Props {
+		switch name {
+		case ical.PropRecurrenceRule, ical.PropRecurrenceDates, ical.PropExceptionDates:
+			continue
+		}
+		for _, prop := range props {
+			override.Props.Add(copyProp(prop))
+		}
+	}
+	override.Children = append(override.Children, master.Children...)
+
+	dtstart := master.Props.Get(ical.PropDateTimeStart)
+	override.Props.Set(sameKind(ical.PropRecurrenceID, dtstart, recurrenceID, z))
+	override.Props.Set(sameKind(ical.PropDateTimeStart, dtstart, recurrenceID, z))
+	if dtend := master.Props.Get(ical.PropDateTimeEnd); dtend != nil {
+		override.Props.Set(sameKind(ical.PropDateTimeEnd, dtend, recurrenceID.Add(end.Sub(start)), z))
+	}
+
+	cal.Children = append(cal.Children, override)
+	return override, nil
+}
+
+// SetPartStat records the user's response in an event's attendee list.
+func SetPartStat(vevent *ical.Component, self []string, response core.ResponseType) error {
+	if isSelf(address(propText(vevent, ical.PropOrganizer)), self) {
+		return core.ErrIsOrganizer
+	}
+
+	var partStat string
+	switch response {
+	case core.ResponseAccept:
+		partStat = "ACCEPTED"
+	case core.ResponseDecline:
+		partStat = "DECLINED"
+	case core.ResponseTentative:
+		partStat = "TENTATIVE"
+	default:
+		return fmt.Errorf("invalid response type")
+	}
+
+	attendees := vevent.Props[ical.PropAttendee]
+	for i := range attendees {
+		if !isSelf(address(attendees[i].Value), self) {
+			continue
+		}
+		attendees[i].Params.Set(ical.ParamParticipationStatus, partStat)
+		attendees[i].Params.Del(ical.ParamRSVP)
+		vevent.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+		return nil
+	}
+
+	return core.ErrNotAttendee
+}
+
+// sameKind builds a date or date-time property for t, using the same value
+// type and time zone as model.
+func sameKind(name string, model *ical.Prop, t time.Time, z zones) *ical.Prop {
+	prop := ical.NewProp(name)
+
+	if model.ValueType() == ical.ValueDate || len(model.Value) == len("20060102") {
+		prop.SetDate(t.In(time.Local))
+		return prop
+	}
+
+	if tzid := model.Params.Get(ical.PropTimezoneID); tzid != "" {
+		prop.Params.Set(ical.PropTimezoneID, tzid)
+		if loc, ok := z.location(tzid); ok {
+			t = t.In(loc)
+		} else {
+			t = t.In(time.Local)
+		}
+		prop.Value = t.Format(floatingFormat)
+		return prop
+	}
+
+	if strings.HasSuffix(model.Value, "Z") {
+		prop.Value = t.UTC().Format(utcFormat)
+		return prop
+	}
+
+	// Floating time
+	prop.Value = t.In(time.Local).Format(floatingFormat)
+	return prop
+}
+
+func copyProp(prop ical.Prop) *ical.Prop {
+	params := make(ical.Params, len(prop.Params))
+	for k, v := range prop.Params {
+		params[k] = append([]string(nil), v...)
+	}
+	prop.Params = params
+	return &prop
+}
+
+// Respond records the user's response to the event with the given ID in
+// cal. For an instance of a recurring event, the scope decides whether only
+// that instance (through an override) or the whole series is updated.
+// Responding to this and following instances would need a RANGE override,
+// which few servers honour, so it is refused.
+func Respond(cal *ical.Calendar, eventID string, self []string, response core.ResponseType, scope core.RecurringScope) error {
+	uid, recurrenceID := recurrence.SplitID(eventID)
+	if !recurrenceID.IsZero() && scope == core.RecurringScopeThisAndFollowing {
+		return fmt.Errorf("responding to this and following events is not supported for this calendar")
+	}
+
+	var targets []*ical.Component
+	if recurrenceID.IsZero() || scope == core.RecurringScopeAllInstances {
+		if master := Master(cal, uid); master != nil {
+			targets = append(targets, master)
+		}
+		targets = append(targets, Overrides(cal, uid)...)
+	} else {
+		instance, err := Instance(cal, uid, recurrenceID)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, instance)
+	}
+
+	if len(targets) == 0 {
+		return fmt.Errorf("event %s not found", eventID)
+	}
+	if IsCancelled(targets[0]) {
+		return fmt.Errorf("cannot respond to a cancelled event")
+	}
+
+	for _, vevent := range targets {
+		if err := SetPartStat(vevent, self, response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package icalendar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// zones holds the time zones a calendar defines in VTIMEZONE components,
+// by TZID.
+type zones map[string]*time.Location
+
+// calendarZones collects the VTIMEZONE definitions of cal.
+func calendarZones(cal *ical.Calendar) zones {
+	z := make(zones)
+	for _, comp := range cal.Children {
+		if comp.Name != ical.CompTimezone {
+			continue
+		}
+		tzid := propText(comp, ical.PropTimezoneID)
+		if tzid == "" {
+			continue
+		}
+		if loc, err := vtimezone(comp); err == nil {
+			z[tzid] = loc
+		}
+	}
+	return z
+}
+
+// location resolves a TZID. Zone names tsk knows (see util.LoadLocation)
+// come first, since a VTIMEZONE usually only carries the current rules.
+func (z zones) location(tzid string) (*time.Location, bool) {
+	if loc, err := util.LoadLocation(tzid); err == nil {
+		return loc, true
+	}
+	loc, ok := z[tzid]
+	return loc, ok
+}
+
+// vtimezone builds a location from a VTIMEZONE: the zone named by
+// X-LIC-LOCATION if there is one, otherwise one following its latest
+// standard and daylight observances. Rules that can't be expressed as
+// "nth weekday of a month" keep standard time all year.
+func vtimezone(comp *ical.Component) (*time.Location, error) {
+	tzid := propText(comp, ical.PropTimezoneID)
+	if name := propText(comp, "X-LIC-LOCATION"); name != "" {
+		if loc, err := util.LoadLocation(name); err == nil {
+			return loc, nil
+		}
+	}
+
+	std := latestObservance(comp, ical.CompTimezoneStandard)
+	dst := latestObservance(comp, ical.CompTimezoneDaylight)
+	if std == nil {
+		return nil, fmt.Errorf("time zone %s has no standard time", tzid)
+	}
+	stdOffset, err := utcOffset(std)
+	if err != nil {
+		return nil, fmt.Errorf("time zone %s: %w", tzid, err)
+	}
+	stdName := zoneName(std, stdOffset)
+	if dst == nil {
+		return time.FixedZone(stdName, stdOffset), nil
+	}
+
+	dstOffset, err1 := utcOffset(dst)
+	dstStart, err2 := posixRule(dst)
+	dstEnd, err3 := posixRule(std)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return time.FixedZone(stdName, stdOffset), nil
+	}
+
+	rule := fmt.Sprintf("<%s>%s<%s>%s,%s,%s",
+		stdName, posixOffset(stdOffset), zoneName(dst, dstOffset), posixOffset(dstOffset), dstStart, dstEnd)
+	return time.LoadLocationFromTZData(tzid, tzif(stdName, stdOffset, rule))
+}
+
+// latestObservance returns the STANDARD or DAYLIGHT child that took effect
+// last, or nil.
+func latestObservance(comp *ical.Component, name string) *ical.Component {
+	var latest *ical.Component
+	var latestStart time.Time
+	for _, child := range comp.Children {
+		if child.Name != name {
+			continue
+		}
+		prop := child.Props.Get(ical.PropDateTimeStart)
+		if prop == nil {
+			continue
+		}
+		start, err := prop.DateTime(time.UTC)
+		if err != nil {
+			continue
+		}
+		if latest == nil || start.After(latestStart) {
+			latest, latestStart = child, start
+		}
+	}
+	return latest
+}
+
+// utcOffset parses an observance's TZOFFSETTO (+0100, -0530 or +013000)
+// into seconds east of UTC.
+func utcOffset(obs *ical.Component) (int, error) {
+	value := propText(obs, ical.PropTimezoneOffsetTo)
+	if (len(value) != 5 && len(value) != 7) || (value[0] != '+' && value[0] != '-') {
+		return 0, fmt.Errorf("invalid TZOFFSETTO %q", value)
+	}
+
+	offset := 0
+	for i, unit := range []int{3600, 60, 1} {
+		if 1+2*i >= len(value) {
+			break
+		}
+		n, err := strconv.Atoi(value[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid TZOFFSETTO %q", value)
+		}
+		offset += n * unit
+	}
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// zoneName returns an observance's TZNAME, or its offset (+0100) when the
+// name is missing or can't be used in a POSIX TZ string.
+func zoneName(obs *ical.Component, offset int) string {
+	if name := propText(obs, ical.PropTimezoneName); len(name) >= 3 && strings.IndexFunc(name, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '+' || r == '-')
+	}) < 0 {
+		return name
+	}
+
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+}
+
+// posixOffset formats an offset for a POSIX TZ string, which counts hours
+// west of UTC.
+func posixOffset(offset int) string {
+	sign := "-"
+	if offset <= 0 {
+		sign, offset = "", -offset
+	}
+	return fmt.Sprintf("%s%d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+}
+
+// posixRule converts when an observance takes effect into a POSIX TZ rule
+// (Mm.w.d/time). Only yearly rules on the nth (or last) weekday of a month
+// are supported, either as BYDAY=-1SU or as BYDAY=SU;BYMONTHDAY=8,...,14.
+func posixRule(obs *ical.Component) (string, error) {
+	prop := obs.Props.Get(ical.PropDateTimeStart)
+	rrule := propText(obs, ical.PropRecurrenceRule)
+	if prop == nil || rrule == "" {
+		return "", fmt.Errorf("observance doesn't repeat")
+	}
+	start, err := prop.DateTime(time.UTC)
+	if err != nil {
+		return "", err
+	}
+
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rrule, ";") {
+		if k, v, ok := strings.Cut(part, "="); ok {
+			parts[strings.ToUpper(k)] = strings.ToUpper(v)
+		}
+	}
+	if parts["FREQ"] != "YEARLY" {
+		return "", fmt.Errorf("unsupported rule %q", rrule)
+	}
+
+	month, err := strconv.Atoi(parts["BYMONTH"])
+	if err != nil || month < 1 || month > 12 {
+		return "", fmt.Errorf("unsupported rule %q", rrule)
+	}
+
+	byDay := parts["BYDAY"]
+	if len(byDay) < 2 {
+		return "", fmt.Errorf("unsupported rule %q", rrule)
+	}
+	weekday := strings.Index("SUMOTUWETHFRSA", byDay[len(byDay)-2:])
+	if weekday < 0 || weekday%2 != 0 {
+		return "", fmt.Errorf("unsupported rule %q", rrule)
+	}
+
+	// POSIX week 5 is the last one of the month
+	var week int
+	if ordinal := byDay[:len(byDay)-2]; ordinal != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(ordinal, "+"))
+		if err != nil || n < -1 || n == 0 || n > 5 {
+			return "", fmt.Errorf("unsupported rule %q", rrule)
+		}
+		week = n
+		if n == -1 {
+			week = 5
+		}
+	} else {
+		first, _, _ := strings.Cut(parts["BYMONTHDAY"], ",")
+		day, err := strconv.Atoi(first)
+		if err != nil || day < 1 {
+			return "", fmt.Errorf("unsupported rule %q", rrule)
+		}
+		week = (day-1)/7 + 1
+		if day > 22 {
+			week = 5
+		}
+	}
+
+	return fmt.Sprintf("M%d.%d.%d/%d:%02d:%02d", month, week, weekday/2,
+		start.Hour(), start.Minute(), start.Second()), nil
+}
+
+// tzif encodes a zone as TZif (version 2) data with no transitions, only
+// a standard time and a POSIX TZ rule that covers all years.
+func tzif(name string, offset int, rule string) []byte {
+	var buf bytes.Buffer
+	for range 2 { // the 32-bit and 64-bit sections are the same
+		buf.WriteString("TZif2")
+		buf.Write(make([]byte, 15))
+		// UT/local and standard/wall indicators, leap seconds, transitions
+		// and local time types, abbreviation bytes
+		for _, n := range []uint32{0, 0, 0, 0, 1, uint32(len(name) + 1)} {
+			binary.Write(&buf, binary.BigEndian, n)
+		}
+		binary.Write(&buf, binary.BigEndian, int32(offset))
+		buf.Write([]byte{0, 0}) // not DST, abbreviation at index 0
+		buf.WriteString(name)
+		buf.WriteByte(0)
+	}
+	buf.WriteString("\n" + rule + "\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,140 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// customZone is how Outlook exports a zone without a standard name.
+const customZone = `BEGIN:VTIMEZONE
+TZID:Customized Time Zone
+BEGIN:STANDARD
+DTSTART:16010101T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+RRULE:FREQ=YEARLY;INTERVAL=1;BYDAY=-1SU;BYMONTH=10
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010101T020000
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+RRULE:FREQ=YEARLY;INTERVAL=1;BYDAY=-1SU;BYMONTH=3
+END:DAYLIGHT
+END:VTIMEZONE
+`
+
+// oldUSZone uses the pre-2007 BYMONTHDAY form of US rules.
+const oldUSZone = `BEGIN:VTIMEZONE
+TZID:Eastern
+BEGIN:STANDARD
+DTSTART:19671029T020000
+TZOFFSETFROM:-0400
+TZOFFSETTO:-0500
+RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=SU;BYMONTHDAY=1,2,3,4,5,6,7
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:19870405T020000
+TZOFFSETFROM:-0500
+TZOFFSETTO:-0400
+RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=SU;BYMONTHDAY=8,9,10,11,12,13,14
+END:DAYLIGHT
+END:VTIMEZONE
+`
+
+const fixedZone = `BEGIN:VTIMEZONE
+TZID:India
+BEGIN:STANDARD
+DTSTART:16010101T000000
+TZOFFSETFROM:+0530
+TZOFFSETTO:+0530
+END:STANDARD
+END:VTIMEZONE
+`
+
+const licZone = `BEGIN:VTIMEZONE
+TZID:Berlin (Lightning)
+X-LIC-LOCATION:Europe/Berlin
+BEGIN:STANDARD
+DTSTART:19701025T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+END:VTIMEZONE
+`
+
+func TestEventsTimeZones(t *testing.T) {
+	tests := []struct {
+		name      string
+		vtimezone string
+		tzid      string
+		start     string
+		// want is the start in UTC
+		want string
+	}{
+		{name: "IANA", tzid: "Europe/Berlin", start: "20260302T100000", want: "2026-03-02 09:00"},
+		{name: "Windows", tzid: "W. Europe Standard Time", start: "20260706T100000", want: "2026-07-06 08:00"},
+		{name: "vendor prefix", tzid: "/mozilla.org/20050126_1/Europe/Berlin", start: "20260706T100000", want: "2026-07-06 08:00"},
+		{name: "VTIMEZONE in winter", vtimezone: customZone, tzid: "Customized Time Zone", start: "20260302T100000", want: "2026-03-02 09:00"},
+		{name: "VTIMEZONE in summer", vtimezone: customZone, tzid: "Customized Time Zone", start: "20260706T100000", want: "2026-07-06 08:00"},
+		{name: "VTIMEZONE before the switch", vtimezone: customZone, tzid: "Customized Time Zone", start: "20260328T100000", want: "2026-03-28 09:00"},
+		{name: "VTIMEZONE after the switch", vtimezone: customZone, tzid: "Customized Time Zone", start: "20260329T100000", want: "2026-03-29 08:00"},
+		{name: "VTIMEZONE with month days", vtimezone: oldUSZone, tzid: "Eastern", start: "20260309T100000", want: "2026-03-09 14:00"},
+		{name: "VTIMEZONE with month days in winter", vtimezone: oldUSZone, tzid: "Eastern", start: "20260302T100000", want: "2026-03-02 15:00"},
+		{name: "VTIMEZONE without DST", vtimezone: fixedZone, tzid: "India", start: "20260706T100000", want: "2026-07-06 04:30"},
+		{name: "X-LIC-LOCATION", vtimezone: licZone, tzid: "Berlin (Lightning)", start: "20260706T100000", want: "2026-07-06 08:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := "BEGIN:VCALENDAR\nVERSION:2.0\nPRODID:-//test//EN\n" + tt.vtimezone +
+				"BEGIN:VEVENT\nUID:ev1\nDTSTAMP:20260101T000000Z\n" +
+				"DTSTART;TZID=" + tt.tzid + ":" + tt.start + "\nSUMMARY:Meeting\n" +
+				"END:VEVENT\nEND:VCALENDAR\n"
+			cal, err := ical.NewDecoder(strings.NewReader(data)).Decode()
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+
+			events := Events(cal, nil, core.FetchOptions{
+				Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			})
+			if len(events) != 1 {
+				t.Fatalf("got %d events, want 1", len(events))
+			}
+			if got := events[0].Start.UTC().Format("2006-01-02 15:04"); got != tt.want {
+				t.Errorf("start = %s UTC, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandInVTimezone(t *testing.T) {
+	data := "BEGIN:VCALENDAR\nVERSION:2.0\nPRODID:-//test//EN\n" + customZone +
+		"BEGIN:VEVENT\nUID:weekly\nDTSTAMP:20260101T000000Z\n" +
+		"DTSTART;TZID=Customized Time Zone:20260323T100000\n" +
+		"DTEND;TZID=Customized Time Zone:20260323T103000\n" +
+		"RRULE:FREQ=WEEKLY;COUNT=2\nSUMMARY:Weekly\nEND:VEVENT\nEND:VCALENDAR\n"
+	cal, err := ical.NewDecoder(strings.NewReader(data)).Decode()
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	events := Events(cal, nil, core.FetchOptions{
+		Start: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC),
+	})
+	var got []string
+	for _, e := range events {
+		got = append(got, e.Start.UTC().Format("Jan 2 15:04"))
+	}
+	// 10:00 local on both sides of the switch to summer time
+	if want := "Mar 23 09:00, Mar 30 08:00"; strings.Join(got, ", ") != want {
+		t.Errorf("got %v, want %s", got, want)
+	}
+}
@@ -0,0 +1,311 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// FileStorage is a core.Storage backed by plain JSON files.
+// Events are kept in one file per provider and calendar:
+//
+//	<dir>/events/<providerID>/<calendarID>.json
+//
+// Sync bookkeeping for each provider lives in <dir>/state/<providerID>.json.
+type FileStorage struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileStorage creates a storage rooted at dir. The directory is created
+// lazily on the first write.
+func NewFileStorage(dir string) *FileStorage {
+	return &FileStorage{dir: dir}
+}
+
+// DefaultDir returns the default cache location (~/.cache/tsk).
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cache", "tsk"), nil
+}
+
+// Dir returns the root directory of the storage.
+func (s *FileStorage) Dir() string {
+	return s.dir
+}
+
+// SyncEvents upserts events, keyed by provider, calendar and event ID.
+func (s *FileStorage) SyncEvents(ctx context.Context, events []core.Event) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Group by file so each calendar file is rewritten once
+	grouped := make(map[string][]core.Event)
+	for _, event := range events {
+		if event.ProviderID == "" {
+			return fmt.Errorf("event %s has no provider ID", event.ID)
+		}
+		path := s.calendarFile(event.ProviderID, event.Calendar.ID)
+		grouped[path] = append(grouped[path], event)
+	}
+
+	for path, batch := range grouped {
+		existing, err := readEvents(path)
+		if err != nil {
+			return err
+		}
+
+		index := make(map[string]int, len(existing))
+		for i, e := range existing {
+			index[e.ID] = i
+		}
+
+		for _, event := range batch {
+			if i, ok := index[event.ID]; ok {
+				existing[i] = event
+			} else {
+				index[event.ID] = len(existing)
+				existing = append(existing, event)
+			}
+		}
+
+		if err := writeJSON(path, existing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListEvents returns all stored events matching the filter, sorted by start time.
+func (s *FileStorage) ListEvents(ctx context.Context, filter core.EventFilter) ([]core.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, err := s.matchingFiles(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []core.Event
+	for _, path := range paths {
+		events, err := readEvents(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if matches(event, filter) {
+				results = append(results, event)
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Start.Before(results[j].Start)
+	})
+
+	return results, nil
+}
+
+// DeleteEvents removes stored events matching the filter.
+func (s *FileStorage) DeleteEvents(ctx context.Context, filter core.EventFilter) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, err := s.matchingFiles(filter)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		events, err := readEvents(path)
+		if err != nil {
+			return err
+		}
+
+		var kept []core.Event
+		for _, event := range events {
+			if !matches(event, filter) {
+				kept = append(kept, event)
+			}
+		}
+
+		if len(kept) == len(events) {
+			continue
+		}
+		if len(kept) == 0 {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		if err := writeJSON(path, kept); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PurgeProvider removes all events and sync state for a provider.
+func (s *FileStorage) PurgeProvider(ctx context.Context, providerID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.RemoveAll(s.providerDir(providerID)); err != nil {
+		return err
+	}
+	if err := os.Remove(s.stateFile(providerID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// matchingFiles lists the calendar files selected by the filter's
+// provider and calendar IDs.
+func (s *FileStorage) matchingFiles(filter core.EventFilter) ([]string, error) {
+	providerIDs := filter.ProviderIDs
+	if len(providerIDs) == 0 {
+		entries, err := os.ReadDir(filepath.Join(s.dir, "events"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				providerIDs = append(providerIDs, unescape(entry.Name()))
+			}
+		}
+	}
+
+	var paths []string
+	for _, providerID := range providerIDs {
+		if len(filter.CalendarIDs) > 0 {
+			for _, calID := range filter.CalendarIDs {
+				paths = append(paths, s.calendarFile(providerID, calID))
+			}
+			continue
+		}
+
+		entries, err := os.ReadDir(s.providerDir(providerID))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+				paths = append(paths, filepath.Join(s.providerDir(providerID), entry.Name()))
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+func (s *FileStorage) providerDir(providerID string) string {
+	return filepath.Join(s.dir, "events", escape(providerID))
+}
+
+func (s *FileStorage) calendarFile(providerID, calendarID string) string {
+	return filepath.Join(s.providerDir(providerID), escape(calendarID)+".json")
+}
+
+func (s *FileStorage) stateFile(providerID string) string {
+	return filepath.Join(s.dir, "state", escape(providerID)+".json")
+}
+
+// matches reports whether an event falls within the filter's time window
+// and, if the filter names event IDs, is one of them.
+func matches(event core.Event, filter core.EventFilter) bool {
+	if len(filter.EventIDs) > 0 && !containsID(filter.EventIDs, event.ID) {
+		return false
+	}
+	if !filter.End.IsZero() && !event.Start.Before(filter.End) {
+		return false
+	}
+	if !filter.Start.IsZero() && !event.End.After(filter.Start) && !event.Start.Equal(filter.Start) {
+		return false
+	}
+	return true
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// escape makes an ID safe to use as a file name.
+// Calendar IDs contain '@', '#' and (for Outlook) '/' characters.
+func escape(id string) string {
+	return url.PathEscape(id)
+}
+
+func unescape(name string) string {
+	if id, err := url.PathUnescape(name); err == nil {
+		return id
+	}
+	return name
+}
+
+// readEvents loads a calendar file. A missing file is an empty calendar.
+func readEvents(path string) ([]core.Event, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var events []core.Event
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+	return events, nil
+}
+
+// writeJSON writes v to path atomically so a crash mid-write never
+// leaves a truncated cache file behind.
+func writeJSON(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return util.WriteFileAtomic(path, data, 0600)
+}
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+func TestMatches(t *testing.T) {
+	timed := core.Event{Start: at("2026-03-04 10:00"), End: at("2026-03-04 11:00")}
+	allDay := core.Event{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00"), IsAllDay: true}
+	instant := core.Event{Start: at("2026-03-04 10:00"), End: at("2026-03-04 10:00")}
+
+	tests := []struct {
+		name   string
+		event  core.Event
+		filter core.EventFilter
+		want   bool
+	}{
+		{name: "open window", event: timed, want: true},
+		{name: "open start", event: timed, filter: core.EventFilter{End: at("2026-03-04 10:30")}, want: true},
+		{name: "open start ends at event start", event: timed, filter: core.EventFilter{End: at("2026-03-04 10:00")}},
+		{name: "open end", event: timed, filter: core.EventFilter{Start: at("2026-03-04 10:30")}, want: true},
+		{name: "open end starts at event end", event: timed, filter: core.EventFilter{Start: at("2026-03-04 11:00")}},
+		{name: "inside window", event: timed, filter: core.EventFilter{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00")}, want: true},
+		{name: "window inside event", event: timed, filter: core.EventFilter{Start: at("2026-03-04 10:15"), End: at("2026-03-04 10:45")}, want: true},
+		{name: "before window", event: timed, filter: core.EventFilter{Start: at("2026-03-05 00:00"), End: at("2026-03-06 00:00")}},
+		{name: "all-day on its day", event: allDay, filter: core.EventFilter{Start: at("2026-03-04 12:00"), End: at("2026-03-04 13:00")}, want: true},
+		{name: "all-day end is exclusive", event: allDay, filter: core.EventFilter{Start: at("2026-03-05 00:00"), End: at("2026-03-06 00:00")}},
+		{name: "all-day ends before window", event: allDay, filter: core.EventFilter{Start: at("2026-03-03 00:00"), End: at("2026-03-04 00:00")}},
+		{name: "zero-length at window start", event: instant, filter: core.EventFilter{Start: at("2026-03-04 10:00"), End: at("2026-03-04 11:00")}, want: true},
+		{name: "zero-length at window end", event: instant, filter: core.EventFilter{Start: at("2026-03-04 09:00"), End: at("2026-03-04 10:00")}},
+		{name: "listed event ID", event: core.Event{ID: "a", Start: timed.Start, End: timed.End}, filter: core.EventFilter{EventIDs: []string{"b", "a"}}, want: true},
+		{name: "unlisted event ID", event: core.Event{ID: "a", Start: timed.Start, End: timed.End}, filter: core.EventFilter{EventIDs: []string{"b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matches(tt.event, tt.filter); got != tt.want {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for _, id := range []string{
+		"primary",
+		"user@example.com",
+		"abc123@group.calendar.google.com",
+		"AAMkAD/x+y==",
+		"en.usa#holiday@group.v.calendar.google.com",
+		"100%",
+	} {
+		name := escape(id)
+		if strings.ContainsAny(name, "/#") {
+			t.Errorf("escape(%q) = %q, not a safe file name", id, name)
+		}
+		if got := unescape(name); got != id {
+			t.Errorf("unescape(escape(%q)) = %q", id, got)
+		}
+	}
+}
+
+func TestDeleteEvents(t *testing.T) {
+	event := func(provider, calendar, id string) core.Event {
+		start := at("2026-03-04 10:00")
+		return core.Event{
+			ID:         id,
+			ProviderID: provider,
+			Calendar:   core.Calendar{ID: calendar},
+			Start:      start,
+			End:        start.Add(time.Hour),
+		}
+	}
+	all := []core.Event{
+		event("work", "user@example.com", "1"),
+		event("work", "user@example.com", "2"),
+		event("work", "team/shared#1", "3"),
+		event("home", "user@example.com", "4"),
+	}
+
+	tests := []struct {
+		name   string
+		filter core.EventFilter
+		want   []string
+	}{
+		{name: "everything", want: nil},
+		{name: "by provider", filter: core.EventFilter{ProviderIDs: []string{"work"}}, want: []string{"4"}},
+		{name: "by calendar", filter: core.EventFilter{ProviderIDs: []string{"work"}, CalendarIDs: []string{"team/shared#1"}}, want: []string{"1", "2", "4"}},
+		{name: "by calendar across providers", filter: core.EventFilter{CalendarIDs: []string{"user@example.com"}}, want: []string{"3"}},
+		{name: "by event ID", filter: core.EventFilter{EventIDs: []string{"2", "4"}}, want: []string{"1", "3"}},
+		{name: "by calendar and event ID", filter: core.EventFilter{CalendarIDs: []string{"user@example.com"}, EventIDs: []string{"1", "3"}}, want: []string{"2", "3", "4"}},
+		{name: "outside the window", filter: core.EventFilter{Start: at("2026-03-05 00:00")}, want: []string{"1", "2", "3", "4"}},
+		{name: "unknown calendar", filter: core.EventFilter{CalendarIDs: []string{"missing"}}, want: []string{"1", "2", "3", "4"}},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewFileStorage(t.TempDir())
+			if err := s.SyncEvents(ctx, all); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.DeleteEvents(ctx, tt.filter); err != nil {
+				t.Fatal(err)
+			}
+
+			events, err := s.ListEvents(ctx, core.EventFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, e := range events {
+				got = append(got, e.ID)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("left %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteEventsRemovesEmptyFiles(t *testing.T) {
+	ctx := context.Background()
+	s := NewFileStorage(t.TempDir())
+	event := core.Event{ID: "1", ProviderID: "work", Calendar: core.Calendar{ID: "a/b"}, Start: at("2026-03-04 10:00"), End: at("2026-03-04 11:00")}
+	if err := s.SyncEvents(ctx, []core.Event{event}); err != nil {
+		t.Fatal(err)
+	}
+
+	path := s.calendarFile("work", "a/b")
+	if filepath.Dir(path) != s.providerDir("work") {
+		t.Fatalf("calendar file %s is not directly in the provider directory", path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.DeleteEvents(ctx, core.EventFilter{EventIDs: []string{"1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("calendar file still exists after deleting its last event (err: %v)", err)
+	}
+}
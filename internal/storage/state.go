@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// ProviderState is the sync bookkeeping kept next to a provider's events.
+type ProviderState struct {
+	// Calendars is the last known calendar list (ID -> Name)
+	Calendars map[string]string
+	// Windows are the time ranges that have been synced, with their sync time.
+	// Windows never overlap; a newer sync replaces the part of an older
+	// window it covers.
+	Windows []SyncWindow
+	// LastSync is the time of the most recent successful sync
+	LastSync time.Time
+	// Deltas holds each calendar's incremental sync token (ID -> token).
+	// The tokens are only valid for the DeltaStart-DeltaEnd window.
+	Deltas     map[string]string
+	DeltaStart time.Time
+	DeltaEnd   time.Time
+}
+
+// SyncWindow is a time range whose events were fetched from the provider.
+type SyncWindow struct {
+	Start    time.Time
+	End      time.Time
+	SyncedAt time.Time
+}
+
+// LoadState reads the sync state for a provider.
+// A provider that was never synced has an empty state.
+func (s *FileStorage) LoadState(providerID string) (ProviderState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var state ProviderState
+	data, err := os.ReadFile(s.stateFile(providerID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return state, err
+	}
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return ProviderState{}, fmt.Errorf("decode state for %s: %w", providerID, err)
+	}
+	return state, nil
+}
+
+// SaveState writes the sync state for a provider.
+func (s *FileStorage) SaveState(providerID string, state ProviderState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return writeJSON(s.stateFile(providerID), state)
+}
+
+// MarkSynced records that [start, end) was synced at the given time.
+func (st *ProviderState) MarkSynced(start, end, at time.Time) {
+	var windows []SyncWindow
+	for _, w := range st.Windows {
+		// Keep the parts of older windows that the new one doesn't cover
+		if w.Start.Before(start) {
+			windows = append(windows, SyncWindow{Start: w.Start, End: minTime(w.End, start), SyncedAt: w.SyncedAt})
+		}
+		if w.End.After(end) {
+			windows = append(windows, SyncWindow{Start: maxTime(w.Start, end), End: w.End, SyncedAt: w.SyncedAt})
+		}
+	}
+	windows = append(windows, SyncWindow{Start: start, End: end, SyncedAt: at})
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+
+	st.Windows = windows
+	if at.After(st.LastSync) {
+		st.LastSync = at
+	}
+}
+
+// Coverage reports whether [start, end) is fully covered by synced windows.
+// When covered, syncedAt is the oldest sync time among the windows involved,
+// i.e. the age of the stalest data in the range.
+func (st ProviderState) Coverage(start, end time.Time) (covered bool, syncedAt time.Time) {
+	cursor := start
+	first := true
+	for _, w := range st.Windows {
+		if !w.End.After(cursor) {
+			continue
+		}
+		if w.Start.After(cursor) {
+			// Gap before this window
+			return false, time.Time{}
+		}
+		if first || w.SyncedAt.Before(syncedAt) {
+			syncedAt = w.SyncedAt
+			first = false
+		}
+		cursor = w.End
+		if !cursor.Before(end) {
+			return true, syncedAt
+		}
+	}
+	return false, time.Time{}
+}
+
+// Invalidate marks every synced window as stale without dropping the
+// cached events, so the next read triggers a refresh.
+func (st *ProviderState) Invalidate() {
+	for i := range st.Windows {
+		st.Windows[i].SyncedAt = time.Time{}
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+// at parses "2006-01-02 15:04" in UTC.
+func at(s string) time.Time {
+	t, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestMarkSynced(t *testing.T) {
+	old := at("2026-03-01 00:00")
+	now := at("2026-03-02 00:00")
+
+	tests := []struct {
+		name     string
+		existing []SyncWindow
+		start    string
+		end      string
+		want     []SyncWindow
+	}{
+		{
+			name:  "first window",
+			start: "2026-03-04 00:00", end: "2026-03-05 00:00",
+			want: []SyncWindow{
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00"), SyncedAt: now},
+			},
+		},
+		{
+			name: "disjoint windows are kept in order",
+			existing: []SyncWindow{
+				{Start: at("2026-03-10 00:00"), End: at("2026-03-11 00:00"), SyncedAt: old},
+			},
+			start: "2026-03-04 00:00", end: "2026-03-05 00:00",
+			want: []SyncWindow{
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00"), SyncedAt: now},
+				{Start: at("2026-03-10 00:00"), End: at("2026-03-11 00:00"), SyncedAt: old},
+			},
+		},
+		{
+			name: "newer window splits an older one",
+			existing: []SyncWindow{
+				{Start: at("2026-03-01 00:00"), End: at("2026-03-10 00:00"), SyncedAt: old},
+			},
+			start: "2026-03-04 00:00", end: "2026-03-05 00:00",
+			want: []SyncWindow{
+				{Start: at("2026-03-01 00:00"), End: at("2026-03-04 00:00"), SyncedAt: old},
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00"), SyncedAt: now},
+				{Start: at("2026-03-05 00:00"), End: at("2026-03-10 00:00"), SyncedAt: old},
+			},
+		},
+		{
+			name: "newer window trims overlapping ones",
+			existing: []SyncWindow{
+				{Start: at("2026-03-01 00:00"), End: at("2026-03-05 00:00"), SyncedAt: old},
+				{Start: at("2026-03-06 00:00"), End: at("2026-03-09 00:00"), SyncedAt: old},
+			},
+			start: "2026-03-04 00:00", end: "2026-03-07 00:00",
+			want: []SyncWindow{
+				{Start: at("2026-03-01 00:00"), End: at("2026-03-04 00:00"), SyncedAt: old},
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-07 00:00"), SyncedAt: now},
+				{Start: at("2026-03-07 00:00"), End: at("2026-03-09 00:00"), SyncedAt: old},
+			},
+		},
+		{
+			name: "newer window replaces covered ones",
+			existing: []SyncWindow{
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-05 00:00"), SyncedAt: old},
+				{Start: at("2026-03-05 00:00"), End: at("2026-03-06 00:00"), SyncedAt: old},
+			},
+			start: "2026-03-04 00:00", end: "2026-03-06 00:00",
+			want: []SyncWindow{
+				{Start: at("2026-03-04 00:00"), End: at("2026-03-06 00:00"), SyncedAt: now},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := ProviderState{Windows: tt.existing}
+			st.MarkSynced(at(tt.start), at(tt.end), now)
+
+			if len(st.Windows) != len(tt.want) {
+				t.Fatalf("got windows %+v, want %+v", st.Windows, tt.want)
+			}
+			for i, w := range st.Windows {
+				if !w.Start.Equal(tt.want[i].Start) || !w.End.Equal(tt.want[i].End) || !w.SyncedAt.Equal(tt.want[i].SyncedAt) {
+					t.Errorf("window %d = %+v, want %+v", i, w, tt.want[i])
+				}
+			}
+			if !st.LastSync.Equal(now) {
+				t.Errorf("LastSync = %v, want %v", st.LastSync, now)
+			}
+		})
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	first := at("2026-03-01 00:00")
+	second := at("2026-03-02 00:00")
+
+	st := ProviderState{}
+	st.MarkSynced(at("2026-03-04 00:00"), at("2026-03-06 00:00"), first)
+	st.MarkSynced(at("2026-03-06 00:00"), at("2026-03-08 00:00"), second)
+	st.MarkSynced(at("2026-03-10 00:00"), at("2026-03-12 00:00"), second)
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		covered  bool
+		syncedAt time.Time
+	}{
+		{name: "inside one window", start: "2026-03-06 12:00", end: "2026-03-07 00:00", covered: true, syncedAt: second},
+		{name: "exactly one window", start: "2026-03-04 00:00", end: "2026-03-06 00:00", covered: true, syncedAt: first},
+		{name: "adjacent windows report the oldest sync", start: "2026-03-05 00:00", end: "2026-03-07 00:00", covered: true, syncedAt: first},
+		{name: "gap between windows", start: "2026-03-07 00:00", end: "2026-03-11 00:00"},
+		{name: "starts before the first window", start: "2026-03-03 00:00", end: "2026-03-05 00:00"},
+		{name: "ends after the last window", start: "2026-03-11 00:00", end: "2026-03-13 00:00"},
+		{name: "outside every window", start: "2026-03-20 00:00", end: "2026-03-21 00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			covered, syncedAt := st.Coverage(at(tt.start), at(tt.end))
+			if covered != tt.covered || !syncedAt.Equal(tt.syncedAt) {
+				t.Errorf("Coverage = %v, %v; want %v, %v", covered, syncedAt, tt.covered, tt.syncedAt)
+			}
+		})
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	st := ProviderState{}
+	st.MarkSynced(at("2026-03-04 00:00"), at("2026-03-05 00:00"), at("2026-03-01 00:00"))
+	st.Invalidate()
+
+	covered, syncedAt := st.Coverage(at("2026-03-04 00:00"), at("2026-03-05 00:00"))
+	if !covered || !syncedAt.IsZero() {
+		t.Errorf("Coverage = %v, %v; want covered with a zero sync time", covered, syncedAt)
+	}
+}
@@ -0,0 +1,276 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+const (
+	agendaChunk     = 7 // Days loaded at a time when scrolling past the end
+	agendaLoadAhead = 3 // Load more when the selection gets this close to the end
+)
+
+// agendaRowKind is what a line of the agenda list shows
+type agendaRowKind int
+
+const (
+	agendaRowHeader agendaRowKind = iota // A day's date
+	agendaRowEvent
+	agendaRowNow    // The NOW divider on today
+	agendaRowFooter // Where the loaded range ends
+)
+
+// agendaRow is one line of the agenda list
+type agendaRow struct {
+	kind agendaRowKind
+	day  time.Time // The day the line belongs to
+	idx  int       // Index into m.events for event rows
+}
+
+// moreEventsLoadedMsg carries the days loaded after the end of the agenda
+type moreEventsLoadedMsg struct {
+	events []core.Event
+	err    error
+	end    time.Time
+}
+
+// loadMoreEvents fetches the next days after the end of the agenda
+func (m Model) loadMoreEvents() tea.Cmd {
+	opts := m.fetchOptions
+	opts.Start = m.agendaEnd
+	opts.End = m.agendaEnd.AddDate(0, 0, agendaChunk)
+	return func() tea.Msg {
+		events, err := m.provider.FetchEvents(context.Background(), opts)
+		return moreEventsLoadedMsg{events: events, err: err, end: opts.End}
+	}
+}
+
+// maybeLoadMore starts loading more days when the selection nears the end
+// of the agenda.
+func (m *Model) maybeLoadMore() tea.Cmd {
+	if m.viewMode != ViewAgenda || m.loadingMore || m.selectedIdx < len(m.events)-agendaLoadAhead {
+		return nil
+	}
+	m.loadingMore = true
+	m.updateListContent()
+	return m.loadMoreEvents()
+}
+
+// appendEvents adds the events of newly loaded days, skipping those already
+// listed because they started earlier and run into the new days.
+func (m *Model) appendEvents(events []core.Event) {
+	seen := make(map[string]bool, len(m.allEvents))
+	for _, e := range m.allEvents {
+		seen[e.ID+"/"+e.Start.String()] = true
+	}
+	all := m.allEvents
+	for _, e := range events {
+		if !seen[e.ID+"/"+e.Start.String()] {
+			all = append(all, e)
+		}
+	}
+	m.setEvents(all)
+}
+
+// agendaDay returns the day an event is listed under: the day it starts,
+// or the first day of the agenda for events that started before it.
+func (m Model) agendaDay(e core.Event) time.Time {
+	day := startOfDay(e.Start)
+	if e.IsAllDay {
+		day = time.Date(e.Start.Year(), e.Start.Month(), e.Start.Day(), 0, 0, 0, 0, time.Local)
+	}
+	if first := startOfDay(m.agendaStart); day.Before(first) {
+		return first
+	}
+	return day
+}
+
+// sortAgenda orders the events by the day they're listed under, all-day
+// events first, so each day's events are together.
+func (m *Model) sortAgenda() {
+	var selectedID string
+	if m.selectedIdx >= 0 && m.selectedIdx < len(m.events) {
+		selectedID = m.events[m.selectedIdx].ID
+	}
+
+	sort.SliceStable(m.events, func(i, j int) bool {
+		a, b := m.events[i], m.events[j]
+		dayA, dayB := m.agendaDay(a), m.agendaDay(b)
+		if !dayA.Equal(dayB) {
+			return dayA.Before(dayB)
+		}
+		if a.IsAllDay != b.IsAllDay {
+			return a.IsAllDay
+		}
+		return a.Start.Before(b.Start)
+	})
+
+	// Keep the selection on the same event
+	for i, e := range m.events {
+		if selectedID != "" && e.ID == selectedID {
+			m.selectedIdx = i
+			break
+		}
+	}
+}
+
+// agendaRows lays out the agenda list: each day with events under its date,
+// and today always, with the NOW divider before its first upcoming event.
+func (m Model) agendaRows() []agendaRow {
+	now := time.Now()
+	today := startOfDay(now)
+	showToday := !today.Before(startOfDay(m.agendaStart)) && today.Before(m.agendaEnd)
+
+	var rows []agendaRow
+	var day time.Time
+	nowAdded := false
+
+	addToday := func() {
+		rows = append(rows, agendaRow{kind: agendaRowHeader, day: today}, agendaRow{kind: agendaRowNow, day: today})
+		nowAdded = true
+	}
+
+	for i, e := range m.events {
+		eventDay := m.agendaDay(e)
+		if !eventDay.Equal(day) {
+			if day.Equal(today) && !nowAdded {
+				// Everything today is over
+				rows = append(rows, agendaRow{kind: agendaRowNow, day: today})
+				nowAdded = true
+			}
+			if showToday && !nowAdded && eventDay.After(today) {
+				// Nothing on today
+				addToday()
+			}
+			day = eventDay
+			rows = append(rows, agendaRow{kind: agendaRowHeader, day: day})
+		}
+		if day.Equal(today) && !nowAdded && !e.IsAllDay && e.Start.After(now) {
+			rows = append(rows, agendaRow{kind: agendaRowNow, day: today})
+			nowAdded = true
+		}
+		rows = append(rows, agendaRow{kind: agendaRowEvent, day: day, idx: i})
+	}
+
+	if day.Equal(today) && !nowAdded {
+		rows = append(rows, agendaRow{kind: agendaRowNow, day: today})
+	} else if showToday && !nowAdded {
+		addToday()
+	}
+
+	if day.IsZero() {
+		day = startOfDay(m.agendaStart)
+	}
+	return append(rows, agendaRow{kind: agendaRowFooter, day: day})
+}
+
+// agendaSelectedRow returns the line of the selected event in rows, or -1
+func (m Model) agendaSelectedRow(rows []agendaRow) int {
+	for r, row := range rows {
+		if row.kind == agendaRowEvent && row.idx == m.selectedIdx {
+			return r
+		}
+	}
+	return -1
+}
+
+// agendaNowEventIdx returns the first upcoming timed event, or the last
+// event when everything is over.
+func (m Model) agendaNowEventIdx() int {
+	now := time.Now()
+	for i, e := range m.events {
+		if !e.IsAllDay && e.Start.After(now) {
+			return i
+		}
+	}
+	if len(m.events) == 0 {
+		return 0
+	}
+	return len(m.events) - 1
+}
+
+// moveAgendaDay moves the selection to the first event of the next or
+// previous day in the agenda.
+func (m *Model) moveAgendaDay(delta int) tea.Cmd {
+	if len(m.events) == 0 {
+		if delta > 0 {
+			return m.maybeLoadMore()
+		}
+		return nil
+	}
+
+	current := m.agendaDay(m.events[m.selectedIdx])
+	target := -1
+	if delta > 0 {
+		for i := m.selectedIdx + 1; i < len(m.events); i++ {
+			if !m.agendaDay(m.events[i]).Equal(current) {
+				target = i
+				break
+			}
+		}
+	} else {
+		// The first event of the day before the current one
+		for i := m.selectedIdx - 1; i >= 0; i-- {
+			day := m.agendaDay(m.events[i])
+			if day.Equal(current) {
+				continue
+			}
+			if target != -1 && !day.Equal(m.agendaDay(m.events[target])) {
+				break
+			}
+			target = i
+		}
+	}
+	if target == -1 {
+		if delta > 0 {
+			m.selectedIdx = len(m.events) - 1
+		} else {
+			m.selectedIdx = 0
+		}
+	} else {
+		m.selectedIdx = target
+	}
+
+	m.updateListContent()
+	m.scrollListToSelection()
+	m.updateDetailContent()
+	m.detailView.GotoTop()
+	return m.maybeLoadMore()
+}
+
+// agendaTitle describes the loaded range for the header
+func (m Model) agendaTitle() string {
+	last := m.agendaEnd.Add(-time.Second)
+	return fmt.Sprintf("Agenda • %s – %s", m.agendaStart.Format("Mon, Jan 2"), last.Format("Mon, Jan 2, 2006"))
+}
+
+// renderAgendaHeader renders the date line above a day's events
+func renderAgendaHeader(day time.Time, width int) string {
+	label := day.Format("Monday, January 2")
+	now := time.Now()
+	switch {
+	case sameDay(day, now):
+		label = "Today • " + label
+	case sameDay(day, now.AddDate(0, 0, 1)):
+		label = "Tomorrow • " + label
+	}
+	return lipgloss.NewStyle().
+		Foreground(primaryColor).
+		Bold(true).
+		Render(fitText(label, width))
+}
+
+// renderAgendaFooter renders the line after the last loaded day
+func (m Model) renderAgendaFooter(width int) string {
+	text := fmt.Sprintf("⋯ until %s • ↓ for more", m.agendaEnd.Add(-time.Second).Format("Mon, Jan 2"))
+	if m.loadingMore {
+		text = "⋯ loading more days"
+	}
+	return WeekMoreStyle.Render(fitText(text, width))
+}
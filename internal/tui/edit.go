@@ -0,0 +1,404 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// Edit form fields, in focus order
+const (
+	editFieldTitle = iota
+	editFieldDate
+	editFieldStart
+	editFieldEnd
+	editFieldLocation
+	editFieldDescription
+	editFieldInvite
+	editFieldRemove
+	editFieldCount
+)
+
+// EditModal is a form for changing an event the user organizes
+type EditModal struct {
+	event          core.Event
+	calendarID     string
+	width          int
+	height         int
+	focusIndex     int
+	inputs         []textinput.Model
+	recurringScope core.RecurringScope
+	err            string
+	update         core.EventUpdate
+	submitted      bool
+	cancelled      bool
+}
+
+// NewEditModal creates a new edit modal pre-filled with the event's details
+func NewEditModal(event core.Event, calendarID string) EditModal {
+	inputs := make([]textinput.Model, editFieldCount)
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].CharLimit = 500
+		inputs[i].Width = 50
+	}
+
+	start, end := event.Start.Local(), event.End.Local()
+	if event.IsAllDay {
+		start = event.Start
+	}
+
+	inputs[editFieldTitle].SetValue(event.Title)
+	inputs[editFieldDate].Placeholder = "2026-03-04"
+	inputs[editFieldDate].SetValue(start.Format("2006-01-02"))
+	inputs[editFieldStart].Placeholder = "empty for all day"
+	inputs[editFieldEnd].Placeholder = "15:00"
+	if !event.IsAllDay {
+		inputs[editFieldStart].SetValue(start.Format("15:04"))
+		inputs[editFieldEnd].SetValue(end.Format("15:04"))
+	}
+	inputs[editFieldLocation].SetValue(event.Location)
+	inputs[editFieldDescription].SetValue(strings.Join(strings.Fields(event.Description), " "))
+	inputs[editFieldInvite].Placeholder = "alex@example.com, sam@example.com"
+	inputs[editFieldRemove].Placeholder = "alex@example.com"
+	inputs[editFieldTitle].Focus()
+
+	return EditModal{
+		event:          event,
+		calendarID:     calendarID,
+		inputs:         inputs,
+		recurringScope: core.RecurringScopeThisInstance,
+	}
+}
+
+// Init initializes the modal
+func (m EditModal) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m EditModal) recurringScopeFocusIdx() int {
+	if m.event.IsRecurring() {
+		return editFieldCount
+	}
+	return -1 // Not shown
+}
+
+func (m EditModal) maxFocusIdx() int {
+	if m.event.IsRecurring() {
+		return editFieldCount
+	}
+	return editFieldCount - 1
+}
+
+// Update handles messages for the edit modal
+func (m EditModal) Update(msg tea.Msg) (EditModal, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, key.NewBinding(key.WithKeys("esc", "ctrl+c"))):
+			m.cancelled = true
+			return m, nil
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("enter"))):
+			update, err := m.buildUpdate()
+			if err != nil {
+				m.err = err.Error()
+				return m, nil
+			}
+			if update.IsEmpty() {
+				m.err = "nothing changed"
+				return m, nil
+			}
+			m.update = update
+			m.submitted = true
+			return m, nil
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("tab", "down"))):
+			m.focusIndex++
+			if m.focusIndex > m.maxFocusIdx() {
+				m.focusIndex = 0
+			}
+			m.updateFocus()
+			return m, nil
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("shift+tab", "up"))):
+			m.focusIndex--
+			if m.focusIndex < 0 {
+				m.focusIndex = m.maxFocusIdx()
+			}
+			m.updateFocus()
+			return m, nil
+
+		case key.Matches(msg, key.NewBinding(key.WithKeys("y", "n", "f"))):
+			if m.focusIndex == m.recurringScopeFocusIdx() {
+				switch msg.String() {
+				case "y":
+					m.recurringScope = core.RecurringScopeAllInstances
+				case "f":
+					m.recurringScope = core.RecurringScopeThisAndFollowing
+				default:
+					m.recurringScope = core.RecurringScopeThisInstance
+				}
+				return m, nil
+			}
+		}
+	}
+
+	if m.focusIndex < editFieldCount {
+		m.inputs[m.focusIndex], cmd = m.inputs[m.focusIndex].Update(msg)
+		m.err = ""
+	}
+	return m, cmd
+}
+
+// updateFocus updates which field is focused
+func (m *EditModal) updateFocus() {
+	for i := range m.inputs {
+		if i == m.focusIndex {
+			m.inputs[i].Focus()
+		} else {
+			m.inputs[i].Blur()
+		}
+	}
+}
+
+// buildUpdate turns the fields that differ from the event into an update
+func (m EditModal) buildUpdate() (core.EventUpdate, error) {
+	original := NewEditModal(m.event, m.calendarID)
+	changed := func(field int) bool {
+		return strings.TrimSpace(m.inputs[field].Value()) != strings.TrimSpace(original.inputs[field].Value())
+	}
+	value := func(field int) string {
+		return strings.TrimSpace(m.inputs[field].Value())
+	}
+
+	update := core.EventUpdate{
+		AddAttendees:    splitEmails(value(editFieldInvite)),
+		RemoveAttendees: splitEmails(value(editFieldRemove)),
+		Scope:           m.recurringScope,
+	}
+
+	if changed(editFieldTitle) {
+		title := value(editFieldTitle)
+		if title == "" {
+			return update, fmt.Errorf("the title cannot be empty")
+		}
+		update.Title = &title
+	}
+	if changed(editFieldLocation) {
+		location := value(editFieldLocation)
+		update.Location = &location
+	}
+	if changed(editFieldDescription) {
+		description := value(editFieldDescription)
+		update.Description = &description
+	}
+
+	if changed(editFieldDate) || changed(editFieldStart) || changed(editFieldEnd) {
+		date, err := time.ParseInLocation("2006-01-02", value(editFieldDate), time.Local)
+		if err != nil {
+			return update, fmt.Errorf("invalid date - use 2026-03-04")
+		}
+
+		if value(editFieldStart) == "" {
+			// All day, keeping the number of days
+			days := 1
+			if m.event.IsAllDay {
+				days = max(1, int(m.event.End.Sub(m.event.Start).Round(24*time.Hour)/(24*time.Hour)))
+			}
+			update.Start = date
+			update.End = date.AddDate(0, 0, days)
+			update.IsAllDay = true
+			return update, nil
+		}
+
+		start, err := clockOn(date, value(editFieldStart))
+		if err != nil {
+			return update, fmt.Errorf("invalid start time - use 14:00")
+		}
+		length := time.Hour
+		if !m.event.IsAllDay {
+			length = m.event.Duration()
+		}
+		end := start.Add(length)
+		if value(editFieldEnd) != "" && (changed(editFieldEnd) || !changed(editFieldStart)) {
+			end, err = clockOn(date, value(editFieldEnd))
+			if err != nil {
+				return update, fmt.Errorf("invalid end time - use 15:00")
+			}
+			if !end.After(start) {
+				// Ends after midnight
+				end = end.AddDate(0, 0, 1)
+			}
+		}
+		update.Start = start
+		update.End = end
+	}
+
+	return update, nil
+}
+
+// clockOn parses a "15:04" time on the given date
+func clockOn(date time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, time.Local), nil
+}
+
+// splitEmails splits a comma or space separated list of addresses
+func splitEmails(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' '
+	})
+}
+
+// View renders the edit modal
+func (m EditModal) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	// Modal dimensions
+	modalWidth := 70
+	if m.width < 80 {
+		modalWidth = m.width - 10
+	}
+
+	inputWidth := modalWidth - 22
+	if inputWidth > 50 {
+		inputWidth = 50
+	}
+
+	var content strings.Builder
+
+	// Header
+	content.WriteString(lipgloss.NewStyle().
+		Foreground(primaryColor).
+		Bold(true).
+		Render("✏️  Edit Event"))
+	content.WriteString("\n\n")
+
+	labels := []string{"Title", "Date", "Start", "End", "Location", "Description", "Invite", "Remove"}
+	for i, label := range labels {
+		input := m.inputs[i]
+		input.Width = inputWidth
+		content.WriteString(m.renderFieldLabel(label, m.focusIndex == i))
+		content.WriteString(input.View())
+		content.WriteString("\n")
+	}
+
+	if m.event.IsRecurring() {
+		content.WriteString("\n")
+		content.WriteString(m.renderRecurringScopeSection())
+		content.WriteString("\n")
+	}
+
+	content.WriteString("\n")
+	if m.err != "" {
+		content.WriteString(lipgloss.NewStyle().
+			Foreground(errorColor).
+			Render("  ⚠ " + m.err))
+		content.WriteString("\n\n")
+	}
+
+	content.WriteString(lipgloss.NewStyle().
+		Foreground(mutedColor).
+		Render("  Dates: 2026-03-04  |  Times: 14:00  |  No start time: all day"))
+	content.WriteString("\n\n")
+
+	// Submit/Cancel
+	submit := lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render("[Enter] Save")
+	cancel := lipgloss.NewStyle().Foreground(mutedColor).Render("[Esc] Cancel")
+	content.WriteString(fmt.Sprintf("  %s    %s", submit, cancel))
+
+	// Wrap in modal box
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1, 2).
+		Width(modalWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content.String()),
+	)
+}
+
+func (m EditModal) renderFieldLabel(label string, focused bool) string {
+	style := lipgloss.NewStyle().Bold(true).Width(15)
+	if focused {
+		style = style.Foreground(accentColor)
+		label = "▶ " + label
+	} else {
+		style = style.Foreground(primaryColor)
+		label = "  " + label
+	}
+	return style.Render(label)
+}
+
+func (m EditModal) renderRecurringScopeSection() string {
+	isFocused := m.focusIndex == m.recurringScopeFocusIdx()
+
+	var options strings.Builder
+	options.WriteString(m.renderFieldLabel("Apply To", isFocused))
+	options.WriteString("\n")
+
+	scopes := []core.RecurringScope{
+		core.RecurringScopeThisInstance,
+		core.RecurringScopeThisAndFollowing,
+		core.RecurringScopeAllInstances,
+	}
+	for _, scope := range scopes {
+		marker := " "
+		style := lipgloss.NewStyle()
+		if m.recurringScope == scope {
+			marker = "●"
+			style = style.Foreground(primaryColor)
+			if isFocused {
+				style = style.Foreground(accentColor).Bold(true)
+			}
+		}
+		label := "This event only (n)"
+		switch scope {
+		case core.RecurringScopeThisAndFollowing:
+			label = "This and following events (f)"
+		case core.RecurringScopeAllInstances:
+			label = "All events in series (y)"
+		}
+		options.WriteString(style.Render(fmt.Sprintf("  %s %s", marker, label)))
+		options.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(options.String(), "\n")
+}
+
+// GetUpdate returns the changes if the form was submitted
+func (m EditModal) GetUpdate() (core.EventUpdate, bool) {
+	if !m.submitted {
+		return core.EventUpdate{}, false
+	}
+	return m.update, true
+}
+
+// Cancelled returns true if the modal was cancelled
+func (m EditModal) Cancelled() bool {
+	return m.cancelled
+}
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"runtime"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,12 +29,22 @@ type KeyMap struct {
 	ViewEvent   key.Binding
 	QuickAccept key.Binding
 	Respond     key.Binding
+	Edit        key.Binding
+	Delete      key.Binding
 	Refresh     key.Binding
 	NextDay     key.Binding
 	PrevDay     key.Binding
 	Today       key.Binding
 	Tab         key.Binding
 	Split       key.Binding
+	Search      key.Binding
+	ClearSearch key.Binding
+	Filters     key.Binding
+	Week        key.Binding
+	Month       key.Binding
+	Agenda      key.Binding
+	PrevPeriod  key.Binding
+	NextPeriod  key.Binding
 	Quit        key.Binding
 	Help        key.Binding
 }
@@ -70,6 +82,14 @@ var DefaultKeyMap = KeyMap{
 		key.WithKeys("r"),
 		key.WithHelp("r", "respond"),
 	),
+	Edit: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "edit"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "delete"),
+	),
 	Refresh: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "sync"),
@@ -91,8 +111,40 @@ var DefaultKeyMap = KeyMap{
 		key.WithHelp("tab", "switch panel"),
 	),
 	Split: key.NewBinding(
+		key.WithKeys("|"),
+		key.WithHelp("|", "toggle split"),
+	),
+	Search: key.NewBinding(
 		key.WithKeys("/"),
-		key.WithHelp("/", "toggle split"),
+		key.WithHelp("/", "search"),
+	),
+	ClearSearch: key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "clear search"),
+	),
+	Filters: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "filter types/responses"),
+	),
+	Week: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "week view"),
+	),
+	Month: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "month view"),
+	),
+	Agenda: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "agenda"),
+	),
+	PrevPeriod: key.NewBinding(
+		key.WithKeys("["),
+		key.WithHelp("[", "prev week/month"),
+	),
+	NextPeriod: key.NewBinding(
+		key.WithKeys("]"),
+		key.WithHelp("]", "next week/month"),
 	),
 	Quit: key.NewBinding(
 		key.WithKeys("q", "ctrl+c"),
@@ -120,40 +172,68 @@ const (
 	FocusDetail
 )
 
+// ViewMode selects how the events of the current date are laid out
+type ViewMode int
+
+const (
+	ViewDay    ViewMode = iota // The day's events as a list
+	ViewWeek                   // The week's events as a grid of days and times
+	ViewMonth                  // The month as a grid of days, without event details
+	ViewAgenda                 // A range of days as one list, loading more at the end
+)
+
 // UIOptions holds global UI configuration
 type UIOptions struct {
 	Split       SplitDirection
-	ListPercent int // 0 = auto/responsive, 10-90 = fixed percentage for list panel
+	ListPercent int      // 0 = auto/responsive, 10-90 = fixed percentage for list panel
+	View        ViewMode // The view to start in
+	AgendaStart time.Time
+	AgendaEnd   time.Time // Zero = a week from AgendaStart
 }
 
 // Model is the Bubble Tea model for the TUI
 type Model struct {
-	events         []core.Event
-	selectedIdx    int
-	currentDate    time.Time
-	width          int
-	height         int
-	listWidth      int
-	detailWidth    int
-	listHeight     int
-	detailHeight   int
-	contentHeight  int
-	keys           KeyMap
-	provider       core.Provider
-	fetchOptions   core.FetchOptions
-	loading        bool
-	err            error
-	listView       viewport.Model
-	detailView     viewport.Model
-	viewportReady  bool
-	compactMode    bool           // True when terminal is too narrow for side-by-side
-	focusedPanel   PanelFocus     // Which panel is shown in compact mode
+	events           []core.Event // The loaded events that pass the search and filters
+	allEvents        []core.Event
+	selectedIdx      int
+	currentDate      time.Time
+	width            int
+	height           int
+	listWidth        int
+	detailWidth      int
+	listHeight       int
+	detailHeight     int
+	contentHeight    int
+	keys             KeyMap
+	provider         core.Provider
+	fetchOptions     core.FetchOptions
+	loading          bool
+	err              error
+	listView         viewport.Model
+	detailView       viewport.Model
+	viewportReady    bool
+	compactMode      bool           // True when terminal is too narrow for side-by-side
+	focusedPanel     PanelFocus     // Which panel is shown in compact mode
 	splitDirection   SplitDirection // Vertical (side-by-side) or horizontal (stacked)
 	listPercent      int            // 0 = auto, 10-90 = user-configured list panel %
 	showHelp         bool           // Whether the help overlay is visible
 	showRespondModal bool           // Whether the respond modal is visible
 	respondModal     RespondModal   // The respond modal component
+	showEditModal    bool           // Whether the edit modal is visible
+	editModal        EditModal      // The edit modal component
+	confirmDelete    bool           // Whether the delete confirmation prompt is showing
 	respondStatus    string         // Status message after responding
+	viewMode         ViewMode       // Day list, week grid, month grid or agenda
+	weekScroll       int            // First time row shown in the week grid
+	agendaStart      time.Time      // First day of the agenda
+	agendaEnd        time.Time      // End of the days loaded into the agenda
+	loadingMore      bool           // Whether more agenda days are being loaded
+	searchInput      textinput.Model
+	searching        bool                      // Whether the search prompt has focus
+	query            string                    // Search terms the events must match
+	showFilters      bool                      // Whether the type and status toggles are showing
+	shownTypes       map[core.EventType]bool   // Event types shown
+	shownStatuses    map[core.EventStatus]bool // Response statuses shown
 }
 
 // NewModel creates a new TUI model
@@ -167,7 +247,19 @@ func NewModel(provider core.Provider, opts core.FetchOptions, uiOpts UIOptions)
 		}
 	}
 
-	return Model{
+	agendaStart := uiOpts.AgendaStart
+	if agendaStart.IsZero() {
+		agendaStart = time.Now()
+	}
+	agendaEnd := uiOpts.AgendaEnd
+	if !agendaEnd.After(agendaStart) {
+		agendaEnd = startOfDay(agendaStart).AddDate(0, 0, agendaChunk)
+	} else if !agendaEnd.Equal(startOfDay(agendaEnd)) {
+		// Whole days only
+		agendaEnd = startOfDay(agendaEnd).AddDate(0, 0, 1)
+	}
+
+	m := Model{
 		events:         []core.Event{},
 		selectedIdx:    0,
 		currentDate:    time.Now(),
@@ -177,12 +269,26 @@ func NewModel(provider core.Provider, opts core.FetchOptions, uiOpts UIOptions)
 		splitDirection: uiOpts.Split,
 		listPercent:    pct,
 		loading:        true,
+		viewMode:       uiOpts.View,
+		agendaStart:    agendaStart,
+		agendaEnd:      agendaEnd,
+		searchInput:    newSearchInput(),
 	}
+	m.initFilters()
+	return m
 }
 
 // findNowEventIdx returns the index of the first upcoming event on today's view,
 // or 0 for other days. This is the event right after where the NOW marker appears.
+// In week view it's the event to select on the current day (see weekSelectIdx),
+// in the agenda the next one from now.
 func (m *Model) findNowEventIdx() int {
+	switch m.viewMode {
+	case ViewWeek:
+		return m.weekSelectIdx()
+	case ViewAgenda:
+		return m.agendaNowEventIdx()
+	}
 	if len(m.events) == 0 {
 		return 0
 	}
@@ -210,10 +316,24 @@ func (m *Model) findNowEventIdx() int {
 // scrollToNow scrolls the list viewport so the NOW marker is visible.
 // It places the NOW marker near the top of the viewport.
 func (m *Model) scrollToNow() {
+	if m.viewMode == ViewWeek {
+		m.resetWeekScroll()
+		return
+	}
 	if !m.viewportReady || len(m.events) == 0 {
 		return
 	}
 
+	if m.viewMode == ViewAgenda {
+		// The selection is the event after NOW
+		offset := m.agendaSelectedRow(m.agendaRows()) - 2
+		if offset < 0 {
+			offset = 0
+		}
+		m.listView.SetYOffset(offset)
+		return
+	}
+
 	now := time.Now()
 	isToday := m.currentDate.Year() == now.Year() &&
 		m.currentDate.Month() == now.Month() &&
@@ -248,6 +368,62 @@ func (m *Model) scrollToNow() {
 	m.listView.SetYOffset(offset)
 }
 
+// selectedEvent returns the event the detail panel and actions apply to.
+// In week view it has to be on the selected day, which may have none; the
+// month view selects days, not events.
+func (m Model) selectedEvent() (core.Event, bool) {
+	if m.selectedIdx < 0 || m.selectedIdx >= len(m.events) || m.viewMode == ViewMonth {
+		return core.Event{}, false
+	}
+	event := m.events[m.selectedIdx]
+	if m.viewMode == ViewWeek && !onDay(event, m.currentDate) {
+		return core.Event{}, false
+	}
+	return event, true
+}
+
+// stacked reports whether the panels are laid out top/bottom. The week grid
+// needs the full width, so it's always stacked over the detail panel.
+func (m Model) stacked() bool {
+	return m.splitDirection == SplitStack || m.viewMode == ViewWeek
+}
+
+// moveSelection moves the selection up or down: through the day's events
+// in week view, by a week in month view, and through the list otherwise.
+func (m *Model) moveSelection(delta int) tea.Cmd {
+	switch m.viewMode {
+	case ViewMonth:
+		return m.moveMonthDay(7 * delta)
+	case ViewWeek:
+		m.moveWeekSelection(delta)
+		return nil
+	}
+	next := m.selectedIdx + delta
+	if next >= 0 && next < len(m.events) {
+		m.selectedIdx = next
+		m.updateListContent()
+		m.scrollListToSelection()
+		m.updateDetailContent()
+		m.detailView.GotoTop()
+	}
+	if delta > 0 {
+		return m.maybeLoadMore()
+	}
+	return nil
+}
+
+// setViewMode switches between the day list, the week and month grids and
+// the agenda, loading the range the new view shows.
+func (m *Model) setViewMode(mode ViewMode) tea.Cmd {
+	m.viewMode = mode
+	m.calculateLayout()
+	if m.viewportReady {
+		m.resizeViewports()
+	}
+	m.loading = true
+	return m.loadEvents()
+}
+
 // resizeViewports updates viewport dimensions based on current layout
 func (m *Model) resizeViewports() {
 	listViewportHeight := m.listHeight - 4
@@ -273,12 +449,34 @@ func (m *Model) resizeViewports() {
 	m.detailView.Height = detailViewportHeight
 }
 
+// cacheUpdater is implemented by providers that refresh cached data in the
+// background and signal when newer data is available.
+type cacheUpdater interface {
+	Updates() <-chan struct{}
+}
+
+// refresher is implemented by providers that can bypass their cache and
+// sync from the source on demand.
+type refresher interface {
+	Refresh(ctx context.Context, opts core.FetchOptions) ([]core.Event, error)
+}
+
+// offlineReporter is implemented by providers that can serve cached data
+// without reaching the source.
+type offlineReporter interface {
+	Offline() bool
+	SyncedAt(start, end time.Time) (time.Time, bool)
+}
+
 // Messages
 type eventsLoadedMsg struct {
 	events []core.Event
 	err    error
+	reload bool // Background reload — keep the current selection
 }
 
+type cacheUpdatedMsg struct{}
+
 type tickMsg time.Time
 
 type responseSubmittedMsg struct {
@@ -286,22 +484,79 @@ type responseSubmittedMsg struct {
 	err     error
 }
 
+type eventUpdatedMsg struct {
+	err error
+}
+
+type eventDeletedMsg struct {
+	err error
+}
+
 // Commands
 func (m Model) loadEvents() tea.Cmd {
 	return func() tea.Msg {
-		// Set date range for current day
-		start := time.Date(m.currentDate.Year(), m.currentDate.Month(), m.currentDate.Day(), 0, 0, 0, 0, m.currentDate.Location())
-		end := start.Add(24 * time.Hour)
+		events, err := m.provider.FetchEvents(context.Background(), m.viewFetchOptions())
+		return eventsLoadedMsg{events: events, err: err}
+	}
+}
 
-		opts := m.fetchOptions
-		opts.Start = start
-		opts.End = end
+// reloadEvents re-reads the current view after a background cache update.
+func (m Model) reloadEvents() tea.Cmd {
+	return func() tea.Msg {
+		events, err := m.provider.FetchEvents(context.Background(), m.viewFetchOptions())
+		return eventsLoadedMsg{events: events, err: err, reload: true}
+	}
+}
 
-		events, err := m.provider.FetchEvents(context.Background(), opts)
+// syncEvents fetches the current view from the source, bypassing any cache.
+func (m Model) syncEvents() tea.Cmd {
+	r, ok := m.provider.(refresher)
+	if !ok {
+		return m.loadEvents()
+	}
+	return func() tea.Msg {
+		events, err := r.Refresh(context.Background(), m.viewFetchOptions())
 		return eventsLoadedMsg{events: events, err: err}
 	}
 }
 
+// waitForCacheUpdate waits for the provider to signal refreshed data.
+func (m Model) waitForCacheUpdate() tea.Cmd {
+	u, ok := m.provider.(cacheUpdater)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		<-u.Updates()
+		return cacheUpdatedMsg{}
+	}
+}
+
+// viewFetchOptions returns the fetch options for the currently viewed day,
+// or its whole week or month (with the days around it in the grid), or the
+// days loaded into the agenda.
+func (m Model) viewFetchOptions() core.FetchOptions {
+	start := time.Date(m.currentDate.Year(), m.currentDate.Month(), m.currentDate.Day(), 0, 0, 0, 0, m.currentDate.Location())
+	end := start.Add(24 * time.Hour)
+	switch m.viewMode {
+	case ViewWeek:
+		start = weekStart(m.currentDate)
+		end = start.AddDate(0, 0, 7)
+	case ViewMonth:
+		var weeks int
+		start, weeks = monthGrid(m.currentDate)
+		end = start.AddDate(0, 0, 7*weeks)
+	case ViewAgenda:
+		start = startOfDay(m.agendaStart)
+		end = m.agendaEnd
+	}
+
+	opts := m.fetchOptions
+	opts.Start = start
+	opts.End = end
+	return opts
+}
+
 func tickCmd() tea.Cmd {
 	return tea.Tick(time.Minute, func(t time.Time) tea.Msg {
 		return tickMsg(t)
@@ -333,6 +588,32 @@ func (m Model) submitResponse(opts core.RespondOptions, proposalStr string, cale
 	}
 }
 
+// submitUpdate sends changes to the selected event via the provider
+func (m Model) submitUpdate(update core.EventUpdate, calendarID string) tea.Cmd {
+	return func() tea.Msg {
+		updater, ok := m.provider.(core.EventUpdater)
+		if !ok {
+			return eventUpdatedMsg{err: core.ErrNotImplemented}
+		}
+		event := m.events[m.selectedIdx]
+		_, err := updater.UpdateEvent(context.Background(), calendarID, event.ID, update)
+		return eventUpdatedMsg{err: err}
+	}
+}
+
+// submitDelete deletes the selected event via the provider
+func (m Model) submitDelete(opts core.DeleteOptions) tea.Cmd {
+	return func() tea.Msg {
+		deleter, ok := m.provider.(core.EventDeleter)
+		if !ok {
+			return eventDeletedMsg{err: core.ErrNotImplemented}
+		}
+		event := m.events[m.selectedIdx]
+		err := deleter.DeleteEvent(context.Background(), event.Calendar.ID, event.ID, opts)
+		return eventDeletedMsg{err: err}
+	}
+}
+
 // parseProposedTime parses a proposed time in the format "start/end"
 func parseProposedTime(proposal string, eventDate time.Time) (*core.TimeProposal, error) {
 	parts := strings.SplitN(proposal, "/", 2)
@@ -399,7 +680,7 @@ func parseTimeWithEventDate(timeStr string, eventDate time.Time) (time.Time, err
 
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return tea.Batch(m.loadEvents(), tickCmd())
+	return tea.Batch(m.loadEvents(), tickCmd(), m.waitForCacheUpdate())
 }
 
 // calculateLayout calculates responsive layout dimensions
@@ -422,12 +703,12 @@ func (m *Model) calculateLayout() {
 
 	// Compact mode threshold - if too narrow for side-by-side
 	compactThreshold := 70
-	if m.splitDirection == SplitStack {
+	if m.stacked() {
 		compactThreshold = 40
 	}
 	m.compactMode = width < compactThreshold
 
-	if m.compactMode {
+	if m.compactMode || m.viewMode == ViewMonth {
 		// Single panel mode - use full width
 		m.listWidth = width - 4
 		m.detailWidth = width - 4
@@ -439,7 +720,7 @@ func (m *Model) calculateLayout() {
 		if m.detailWidth < 20 {
 			m.detailWidth = 20
 		}
-	} else if m.splitDirection == SplitStack {
+	} else if m.stacked() {
 		// Stacked mode - list on top, detail on bottom
 		m.listWidth = width - 4
 		m.detailWidth = width - 4
@@ -453,6 +734,9 @@ func (m *Model) calculateLayout() {
 		listPct := m.listPercent
 		if listPct == 0 {
 			listPct = 40
+			if m.viewMode == ViewWeek {
+				listPct = 60
+			}
 		}
 		m.listHeight = m.contentHeight * listPct / 100
 		m.detailHeight = m.contentHeight - m.listHeight - 1
@@ -544,8 +828,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
+		} else if msg.reload {
+			m.err = nil
+			m.setEvents(msg.events)
+			if _, ok := m.selectedEvent(); !ok && m.viewMode == ViewWeek {
+				m.selectedIdx = m.weekSelectIdx()
+			}
+			m.updateListContent()
+			m.updateDetailContent()
 		} else {
-			m.events = msg.events
+			m.err = nil
+			m.setEvents(msg.events)
 			m.selectedIdx = m.findNowEventIdx()
 			m.updateListContent()
 			m.updateDetailContent()
@@ -553,6 +846,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case moreEventsLoadedMsg:
+		m.loadingMore = false
+		if msg.err != nil {
+			m.respondStatus = fmt.Sprintf("✗ Couldn't load more days: %v", msg.err)
+		} else if m.viewMode == ViewAgenda {
+			m.agendaEnd = msg.end
+			m.appendEvents(msg.events)
+		}
+		m.updateListContent()
+		m.updateDetailContent()
+		return m, nil
+
+	case cacheUpdatedMsg:
+		// Newer data landed in the cache — reload quietly and keep listening
+		return m, tea.Batch(m.reloadEvents(), m.waitForCacheUpdate())
+
 	case tickMsg:
 		// Refresh the view every minute for countdown updates
 		m.updateListContent()
@@ -571,7 +880,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case eventUpdatedMsg:
+		m.showEditModal = false
+		if msg.err != nil {
+			m.respondStatus = m.formatUpdateError(msg.err)
+			return m, nil
+		}
+		m.respondStatus = "✓ Event updated"
+		return m, m.reloadEvents()
+
+	case eventDeletedMsg:
+		if msg.err != nil {
+			m.respondStatus = m.formatDeleteError(msg.err)
+			return m, nil
+		}
+		m.respondStatus = "✓ Event deleted"
+		return m, m.reloadEvents()
+
 	case tea.KeyMsg:
+		// The delete prompt takes the next key: y confirms, anything else cancels
+		if m.confirmDelete {
+			m.confirmDelete = false
+			opts := core.DeleteOptions{Scope: core.RecurringScopeThisInstance}
+			switch msg.String() {
+			case "y":
+			case "f", "Y":
+				if !m.events[m.selectedIdx].IsRecurring() {
+					m.respondStatus = "Delete cancelled"
+					return m, nil
+				}
+				opts.Scope = core.RecurringScopeAllInstances
+				if msg.String() == "f" {
+					opts.Scope = core.RecurringScopeThisAndFollowing
+				}
+			default:
+				m.respondStatus = "Delete cancelled"
+				return m, nil
+			}
+			m.respondStatus = "Deleting event..."
+			return m, m.submitDelete(opts)
+		}
+
+		// When edit modal is shown, pass messages to it
+		if m.showEditModal {
+			var cmd tea.Cmd
+			m.editModal, cmd = m.editModal.Update(msg)
+
+			if m.editModal.Cancelled() {
+				m.showEditModal = false
+				return m, nil
+			}
+
+			if update, ok := m.editModal.GetUpdate(); ok {
+				m.respondStatus = "Saving changes..."
+				return m, m.submitUpdate(update, m.editModal.calendarID)
+			}
+
+			return m, cmd
+		}
+
 		// When respond modal is shown, pass messages to it
 		if m.showRespondModal {
 			var cmd tea.Cmd
@@ -597,6 +964,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// The search prompt and the filter toggles take keys while showing
+		if m.searching {
+			return m, m.updateSearch(msg)
+		}
+		if m.showFilters {
+			m.updateFilterToggles(msg)
+			return m, nil
+		}
+
 		// Clear respond status on any key press (except when modal/help is showing)
 		if m.respondStatus != "" {
 			m.respondStatus = ""
@@ -611,24 +987,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case key.Matches(msg, m.keys.Up):
-			if m.selectedIdx > 0 {
-				m.selectedIdx--
-				m.updateListContent()
-				m.scrollListToSelection()
-				m.updateDetailContent()
-				m.detailView.GotoTop()
-			}
-			return m, nil
+			return m, m.moveSelection(-1)
 
 		case key.Matches(msg, m.keys.Down):
-			if m.selectedIdx < len(m.events)-1 {
-				m.selectedIdx++
-				m.updateListContent()
-				m.scrollListToSelection()
-				m.updateDetailContent()
-				m.detailView.GotoTop()
-			}
-			return m, nil
+			return m, m.moveSelection(1)
 
 		case key.Matches(msg, m.keys.ScrollUp):
 			if m.compactMode && m.focusedPanel == FocusList {
@@ -647,11 +1009,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case key.Matches(msg, m.keys.NextDay):
+			switch m.viewMode {
+			case ViewWeek:
+				return m, m.moveWeekDay(1)
+			case ViewMonth:
+				return m, m.moveMonthDay(1)
+			case ViewAgenda:
+				return m, m.moveAgendaDay(1)
+			}
 			m.currentDate = m.currentDate.AddDate(0, 0, 1)
 			m.loading = true
 			return m, m.loadEvents()
 
 		case key.Matches(msg, m.keys.PrevDay):
+			switch m.viewMode {
+			case ViewWeek:
+				return m, m.moveWeekDay(-1)
+			case ViewMonth:
+				return m, m.moveMonthDay(-1)
+			case ViewAgenda:
+				return m, m.moveAgendaDay(-1)
+			}
 			m.currentDate = m.currentDate.AddDate(0, 0, -1)
 			m.loading = true
 			return m, m.loadEvents()
@@ -699,13 +1077,67 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 
-		case key.Matches(msg, m.keys.Refresh):
+		case key.Matches(msg, m.keys.Search):
+			m.startSearch()
+			return m, nil
+
+		case key.Matches(msg, m.keys.ClearSearch):
+			if m.query != "" {
+				m.query = ""
+				m.refilter()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Filters):
+			m.showFilters = true
+			return m, nil
+
+		case key.Matches(msg, m.keys.Week):
+			if m.viewMode == ViewWeek {
+				return m, m.setViewMode(ViewDay)
+			}
+			return m, m.setViewMode(ViewWeek)
+
+		case key.Matches(msg, m.keys.Month):
+			if m.viewMode == ViewMonth {
+				return m, m.setViewMode(ViewDay)
+			}
+			return m, m.setViewMode(ViewMonth)
+
+		case key.Matches(msg, m.keys.Agenda):
+			if m.viewMode == ViewAgenda {
+				return m, m.setViewMode(ViewDay)
+			}
+			return m, m.setViewMode(ViewAgenda)
+
+		case key.Matches(msg, m.keys.PrevPeriod), key.Matches(msg, m.keys.NextPeriod):
+			dir := 1
+			if key.Matches(msg, m.keys.PrevPeriod) {
+				dir = -1
+			}
+			switch m.viewMode {
+			case ViewWeek:
+				m.currentDate = m.currentDate.AddDate(0, 0, 7*dir)
+			case ViewMonth:
+				// Stay within the month: Jan 31 + 1 month is in March
+				first := time.Date(m.currentDate.Year(), m.currentDate.Month(), 1, 0, 0, 0, 0, time.Local)
+				m.currentDate = first.AddDate(0, dir, 0)
+			default:
+				return m, nil
+			}
 			m.loading = true
 			return m, m.loadEvents()
 
+		case key.Matches(msg, m.keys.Refresh):
+			m.loading = true
+			return m, m.syncEvents()
+
 		case key.Matches(msg, m.keys.Open):
-			if len(m.events) > 0 && m.selectedIdx < len(m.events) {
-				event := m.events[m.selectedIdx]
+			if m.viewMode == ViewMonth {
+				// Drill down into the selected day
+				return m, m.setViewMode(ViewDay)
+			}
+			if event, ok := m.selectedEvent(); ok {
 				if event.MeetingLink != "" {
 					return m, openURL(event.MeetingLink)
 				}
@@ -713,8 +1145,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case key.Matches(msg, m.keys.ViewEvent):
-			if len(m.events) > 0 && m.selectedIdx < len(m.events) {
-				event := m.events[m.selectedIdx]
+			if event, ok := m.selectedEvent(); ok {
 				if event.URL != "" {
 					return m, openURL(event.URL)
 				}
@@ -723,8 +1154,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, m.keys.QuickAccept):
 			// Quick accept - immediately accept the event without modal
-			if len(m.events) > 0 && m.selectedIdx < len(m.events) {
-				event := m.events[m.selectedIdx]
+			if event, ok := m.selectedEvent(); ok {
 				// Check if user can respond to this event
 				if event.Status != core.StatusNoResponse {
 					opts := core.RespondOptions{
@@ -744,8 +1174,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, m.keys.Respond):
 			// Open respond modal if event is selected and user is an attendee
-			if len(m.events) > 0 && m.selectedIdx < len(m.events) {
-				event := m.events[m.selectedIdx]
+			if event, ok := m.selectedEvent(); ok {
 				// Check if user can respond to this event
 				if event.Status != core.StatusNoResponse {
 					m.respondModal = NewRespondModal(event, event.Calendar.ID)
@@ -760,6 +1189,40 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, nil
+
+		case key.Matches(msg, m.keys.Edit):
+			if event, ok := m.selectedEvent(); ok {
+				if _, ok := m.provider.(core.EventUpdater); !ok {
+					m.respondStatus = m.formatUpdateError(core.ErrNotImplemented)
+					return m, nil
+				}
+				m.editModal = NewEditModal(event, event.Calendar.ID)
+				m.editModal.width = m.width
+				m.editModal.height = m.height
+				m.showEditModal = true
+				m.respondStatus = ""
+				return m, m.editModal.Init()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Delete):
+			if event, ok := m.selectedEvent(); ok {
+				if _, ok := m.provider.(core.EventDeleter); !ok {
+					m.respondStatus = m.formatDeleteError(core.ErrNotImplemented)
+					return m, nil
+				}
+				title := event.Title
+				if len(title) > 30 {
+					title = title[:27] + "..."
+				}
+				m.confirmDelete = true
+				if event.IsRecurring() {
+					m.respondStatus = fmt.Sprintf("Delete \"%s\"? y: this event  •  f: this and following  •  Y: all events in series  •  any other key: no", title)
+				} else {
+					m.respondStatus = fmt.Sprintf("Delete \"%s\"? y: yes  •  any other key: no", title)
+				}
+			}
+			return m, nil
 		}
 	}
 	return m, nil
@@ -788,18 +1251,25 @@ func (m Model) View() string {
 			Height(m.contentHeight).
 			Foreground(errorColor).
 			Render(fmt.Sprintf("Error: %v", m.err))
+	} else if m.viewMode == ViewMonth {
+		// The month grid takes the whole width; help replaces it
+		if m.showHelp {
+			content = m.renderHelpPanel()
+		} else {
+			content = m.renderMonthPanel()
+		}
 	} else if m.compactMode {
 		// Single panel mode
 		if m.showHelp {
 			content = m.renderHelpPanel()
 		} else if m.focusedPanel == FocusList {
-			content = m.renderListPanel()
+			content = m.renderMainPanel()
 		} else {
 			content = m.renderDetailPanel()
 		}
-	} else if m.splitDirection == SplitStack {
+	} else if m.stacked() {
 		// Stacked mode — list on top, detail on bottom
-		listPanel := m.renderListPanel()
+		listPanel := m.renderMainPanel()
 		var bottomPanel string
 		if m.showHelp {
 			bottomPanel = m.renderHelpPanel()
@@ -809,7 +1279,7 @@ func (m Model) View() string {
 		content = lipgloss.JoinVertical(lipgloss.Left, listPanel, bottomPanel)
 	} else {
 		// Side-by-side mode — help replaces the detail panel
-		listPanel := m.renderListPanel()
+		listPanel := m.renderMainPanel()
 		var rightPanel string
 		if m.showHelp {
 			rightPanel = m.renderHelpPanel()
@@ -819,8 +1289,15 @@ func (m Model) View() string {
 		content = lipgloss.JoinHorizontal(lipgloss.Top, listPanel, " ", rightPanel)
 	}
 
-	// Help bar with optional status message
+	// Help bar with optional status message; the search prompt and the
+	// filter toggles take its place
 	help := m.renderHelp()
+	switch {
+	case m.searching:
+		help = m.renderSearchBar()
+	case m.showFilters:
+		help = m.renderFilterBar()
+	}
 	if m.respondStatus != "" {
 		statusStyle := lipgloss.NewStyle().Foreground(primaryColor)
 		if strings.HasPrefix(m.respondStatus, "✗") {
@@ -833,6 +1310,14 @@ func (m Model) View() string {
 		lipgloss.JoinVertical(lipgloss.Left, header, content, help),
 	)
 
+	// Show edit modal overlay if active
+	if m.showEditModal {
+		modal := m.editModal.View()
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+			lipgloss.JoinVertical(lipgloss.Center, baseView, modal),
+		)
+	}
+
 	// Show respond modal overlay if active
 	if m.showRespondModal {
 		modal := m.respondModal.View()
@@ -853,7 +1338,13 @@ func (m Model) renderHeader() string {
 		m.currentDate.Month() == now.Month() &&
 		m.currentDate.Day() == now.Day()
 
-	if isToday {
+	if m.viewMode == ViewWeek {
+		dateStr = m.weekTitle()
+	} else if m.viewMode == ViewMonth {
+		dateStr = m.monthTitle()
+	} else if m.viewMode == ViewAgenda {
+		dateStr = m.agendaTitle()
+	} else if isToday {
 		dateStr = "Today • " + dateStr
 	}
 
@@ -876,7 +1367,29 @@ func (m Model) renderHeader() string {
 		}
 	}
 
-	return lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", date, panelIndicator)
+	return lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", date, panelIndicator, m.renderOfflineIndicator())
+}
+
+// renderOfflineIndicator shows how old the displayed data is when the
+// provider is offline.
+func (m Model) renderOfflineIndicator() string {
+	r, ok := m.provider.(offlineReporter)
+	if !ok || !r.Offline() {
+		return ""
+	}
+
+	label := " ⚠ offline"
+	opts := m.viewFetchOptions()
+	if syncedAt, ok := r.SyncedAt(opts.Start, opts.End); ok {
+		label += " • synced " + formatDuration(time.Since(syncedAt)) + " ago"
+	} else {
+		label += " • no cached data"
+	}
+
+	return lipgloss.NewStyle().
+		Foreground(accentColor).
+		Bold(true).
+		Render(" " + label)
 }
 
 // updateListContent updates the list viewport with current events
@@ -885,6 +1398,24 @@ func (m *Model) updateListContent() {
 		return
 	}
 
+	if m.viewMode == ViewAgenda {
+		var items []string
+		for _, row := range m.agendaRows() {
+			switch row.kind {
+			case agendaRowHeader:
+				items = append(items, renderAgendaHeader(row.day, m.listView.Width))
+			case agendaRowNow:
+				items = append(items, m.renderNowDivider())
+			case agendaRowFooter:
+				items = append(items, m.renderAgendaFooter(m.listView.Width))
+			default:
+				items = append(items, m.renderListItem(m.events[row.idx], row.idx == m.selectedIdx, m.listView.Width))
+			}
+		}
+		m.listView.SetContent(strings.Join(items, "\n"))
+		return
+	}
+
 	var items []string
 	if len(m.events) == 0 {
 		items = append(items, NormalItemStyle.Render("No events"))
@@ -966,6 +1497,17 @@ func (m *Model) scrollListToSelection() {
 		return
 	}
 
+	if m.viewMode == ViewAgenda {
+		row := m.agendaSelectedRow(m.agendaRows())
+		if row < m.listView.YOffset {
+			m.listView.SetYOffset(row)
+		}
+		if row >= m.listView.YOffset+m.listView.Height {
+			m.listView.SetYOffset(row - m.listView.Height + 1)
+		}
+		return
+	}
+
 	now := time.Now()
 	isToday := m.currentDate.Year() == now.Year() &&
 		m.currentDate.Month() == now.Month() &&
@@ -1011,8 +1553,16 @@ func (m *Model) scrollListToSelection() {
 	}
 }
 
+// renderMainPanel renders the event list, or the grid in week view
+func (m Model) renderMainPanel() string {
+	if m.viewMode == ViewWeek {
+		return m.renderWeekPanel()
+	}
+	return m.renderListPanel()
+}
+
 func (m Model) renderListPanel() string {
-	if len(m.events) == 0 {
+	if len(m.events) == 0 && m.viewMode != ViewAgenda {
 		return ListPanelStyle.Width(m.listWidth).Height(m.listHeight).Render(
 			lipgloss.NewStyle().
 				Foreground(mutedColor).
@@ -1035,6 +1585,23 @@ func (m Model) renderListPanel() string {
 
 	content := m.listView.View()
 
+	// In the agenda, the date of the day at the top stays in the header
+	if m.viewMode == ViewAgenda && m.viewportReady {
+		rows := m.agendaRows()
+		if top := m.listView.YOffset; top < len(rows) {
+			header = renderAgendaHeader(rows[top].day, m.listView.Width-lipgloss.Width(scrollInfo)) + scrollInfo
+			if rows[top].kind == agendaRowHeader {
+				// Standing in for the day's own date line, which makes room
+				// for one more line at the bottom
+				view := m.listView
+				view.Height++
+				if _, rest, ok := strings.Cut(view.View(), "\n"); ok {
+					content = rest
+				}
+			}
+		}
+	}
+
 	return ListPanelStyle.Width(m.listWidth).Height(m.listHeight).Render(
 		lipgloss.JoinVertical(lipgloss.Left, header, content),
 	)
@@ -1079,6 +1646,7 @@ func (m Model) renderListItem(event core.Event, selected bool, maxWidth int) str
 	if len(title) > titleWidth {
 		title = title[:titleWidth-3] + "..."
 	}
+	title = m.highlightMatches(title)
 
 	// Status indicator (only for in-progress, past events get checkmark in time)
 	statusIcon := ""
@@ -1109,11 +1677,14 @@ func (m Model) renderListItem(event core.Event, selected bool, maxWidth int) str
 
 // updateDetailContent updates the viewport with the current event details
 func (m *Model) updateDetailContent() {
-	if len(m.events) == 0 || !m.viewportReady {
+	if !m.viewportReady {
+		return
+	}
+	event, ok := m.selectedEvent()
+	if !ok {
 		return
 	}
 
-	event := m.events[m.selectedIdx]
 	width := m.detailView.Width
 	var lines []string
 
@@ -1189,6 +1760,15 @@ func (m *Model) updateDetailContent() {
 		lines = append(lines, renderField("📊 Response", formatStatus(event.Status)))
 	}
 
+	// Organizer and attendee responses
+	if event.Organizer != "" {
+		lines = append(lines, renderWrappedField("👤 Organizer", formatPerson(event.OrganizerName, event.Organizer), width))
+	}
+	summary := event.AttendeeSummary()
+	if summary.Total() > 0 {
+		lines = append(lines, renderWrappedField("👥 Attendees", formatAttendeeSummary(summary), width))
+	}
+
 	// Description (convert HTML → plain text, then word-wrap)
 	if event.Description != "" {
 		lines = append(lines, "")
@@ -1198,6 +1778,16 @@ func (m *Model) updateDetailContent() {
 		lines = append(lines, ValueStyle.Render(wrapped))
 	}
 
+	// Guest list
+	if summary.Total() > 0 {
+		lines = append(lines, "")
+		lines = append(lines, LabelStyle.Render("👥 Guests"))
+		maxNameLen := width - 5 // "   ✓ " prefix = 5 chars
+		for _, attendee := range event.Attendees {
+			lines = append(lines, "   "+formatAttendee(attendee, maxNameLen))
+		}
+	}
+
 	// Attachments
 	if len(event.Attachments) > 0 {
 		lines = append(lines, "")
@@ -1220,7 +1810,7 @@ func (m *Model) updateDetailContent() {
 }
 
 func (m Model) renderDetailPanel() string {
-	if len(m.events) == 0 {
+	if _, ok := m.selectedEvent(); !ok {
 		return DetailPanelStyle.Width(m.detailWidth).Height(m.detailHeight).Render(
 			lipgloss.NewStyle().
 				Foreground(mutedColor).
@@ -1254,16 +1844,26 @@ func (m Model) renderHelp() string {
 	keys := []string{
 		HelpKeyStyle.Render("↑/↓/←/→") + " move",
 		HelpKeyStyle.Render("t") + " now",
+		HelpKeyStyle.Render("w/m/g") + " week/month/agenda",
+		HelpKeyStyle.Render("/") + " search",
 		HelpKeyStyle.Render("enter") + " meet",
 		HelpKeyStyle.Render("a") + " accept",
 		HelpKeyStyle.Render("r") + " respond",
+		HelpKeyStyle.Render("e") + " edit",
+		HelpKeyStyle.Render("d") + " delete",
 		HelpKeyStyle.Render("v") + " view",
 		HelpKeyStyle.Render("s") + " sync",
 		HelpKeyStyle.Render("?") + " help",
 		HelpKeyStyle.Render("q") + " quit",
 	}
 
-	fullLine := strings.Join(keys, "  •  ")
+	// A kept search stays in view
+	prefix := ""
+	if m.query != "" {
+		prefix = m.renderSearchStatus() + "  •  "
+	}
+
+	fullLine := prefix + strings.Join(keys, "  •  ")
 
 	// Check if the full help bar fits in the available width
 	// Calculate visual length (without ANSI escape codes)
@@ -1272,7 +1872,7 @@ func (m Model) renderHelp() string {
 
 	if visualLen > maxWidth {
 		// Doesn't fit — show minimal hint
-		return HelpStyle.Render(HelpKeyStyle.Render("?") + " help")
+		return HelpStyle.Render(prefix + HelpKeyStyle.Render("?") + " help")
 	}
 
 	return HelpStyle.Render(fullLine)
@@ -1293,10 +1893,18 @@ func (m Model) renderHelpPanel() string {
 		HelpKeyStyle.Render("  ←          ") + " Previous day",
 		HelpKeyStyle.Render("  t          ") + " Jump to now / today",
 		HelpKeyStyle.Render("  tab        ") + " Switch panel",
-		HelpKeyStyle.Render("  /          ") + " Toggle split direction",
+		HelpKeyStyle.Render("  |          ") + " Toggle split direction",
+		HelpKeyStyle.Render("  /          ") + " Search (esc clears)",
+		HelpKeyStyle.Render("  f          ") + " Show / hide event types and responses",
+		HelpKeyStyle.Render("  w          ") + " Toggle week view",
+		HelpKeyStyle.Render("  m          ") + " Toggle month view (enter opens a day)",
+		HelpKeyStyle.Render("  [ / ]      ") + " Previous / next week or month",
+		HelpKeyStyle.Render("  g          ") + " Toggle agenda (←/→ jump between days)",
 		HelpKeyStyle.Render("  enter      ") + " Start meeting / open event",
 		HelpKeyStyle.Render("  a          ") + " Quick accept event",
 		HelpKeyStyle.Render("  r          ") + " Respond to event (full options)",
+		HelpKeyStyle.Render("  e          ") + " Edit event you organize",
+		HelpKeyStyle.Render("  d          ") + " Delete / cancel event you organize",
 		HelpKeyStyle.Render("  v          ") + " View event in calendar",
 		HelpKeyStyle.Render("  s          ") + " Sync / refresh events",
 		HelpKeyStyle.Render("  q / ctrl+c ") + " Quit",
@@ -1400,6 +2008,65 @@ func formatStatus(status core.EventStatus) string {
 	}
 }
 
+// formatAttendeeSummary formats attendee responses, e.g.
+// "5 guests: 3 accepted, 2 pending"
+func formatAttendeeSummary(s core.AttendeeSummary) string {
+	var parts []string
+	for _, count := range []struct {
+		n     int
+		label string
+	}{
+		{s.Accepted, "accepted"},
+		{s.Declined, "declined"},
+		{s.Tentative, "tentative"},
+		{s.Pending, "pending"},
+	} {
+		if count.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count.n, count.label))
+		}
+	}
+
+	guests := "guests"
+	if s.Total() == 1 {
+		guests = "guest"
+	}
+	return fmt.Sprintf("%d %s: %s", s.Total(), guests, strings.Join(parts, ", "))
+}
+
+// formatAttendee renders one attendee with their response
+func formatAttendee(a core.Attendee, maxLen int) string {
+	text := formatPerson(a.Name, a.Email)
+	if a.IsResource {
+		text += " (resource)"
+	} else if a.Optional {
+		text += " (optional)"
+	}
+	text = util.TruncateText(text, maxLen)
+
+	switch a.Response {
+	case core.StatusAccepted:
+		return StatusAcceptedStyle.Render("✓") + " " + ValueStyle.Render(text)
+	case core.StatusRejected:
+		return StatusDeclinedStyle.Render("✗") + " " + lipgloss.NewStyle().Foreground(mutedColor).Render(text)
+	case core.StatusTentative:
+		return StatusPendingStyle.Render("?") + " " + ValueStyle.Render(text)
+	default:
+		return lipgloss.NewStyle().Foreground(mutedColor).Render("… " + text)
+	}
+}
+
+// formatPerson shows a name with its address, or whichever is known
+func formatPerson(name, email string) string {
+	switch {
+	case name == "" || strings.EqualFold(name, email):
+		return email
+	case email == "":
+		return name
+	default:
+		return fmt.Sprintf("%s <%s>", name, email)
+	}
+}
+
 // formatRespondError converts provider errors to user-friendly messages
 func (m Model) formatRespondError(err error) string {
 	if err == nil {
@@ -1415,6 +2082,8 @@ func (m Model) formatRespondError(err error) string {
 		return "✗ Cannot respond: You are the organizer of this event"
 	case strings.Contains(err.Error(), core.ErrNotImplemented.Error()):
 		return "✗ Response feature not yet supported for this calendar provider"
+	case strings.Contains(err.Error(), core.ErrOffline.Error()):
+		return "✗ Cannot respond while offline"
 	case strings.Contains(err.Error(), "cancelled"):
 		return "✗ Cannot respond: This event has been cancelled"
 	case strings.Contains(err.Error(), "invalid proposed time"):
@@ -1440,6 +2109,46 @@ func (m Model) formatRespondError(err error) string {
 	}
 }
 
+// formatUpdateError converts provider errors from editing to user-friendly messages
+func (m Model) formatUpdateError(err error) string {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return "✗ Insufficient permissions - please re-authenticate with 'tsk auth'"
+	case errors.Is(err, core.ErrNotOrganizer):
+		return "✗ Cannot edit: Only the organizer can change this event"
+	case errors.Is(err, core.ErrNotImplemented):
+		return "✗ Editing events is not supported for this calendar provider"
+	case errors.Is(err, core.ErrOffline):
+		return "✗ Cannot edit while offline"
+	default:
+		errMsg := err.Error()
+		if len(errMsg) > 80 {
+			errMsg = errMsg[:77] + "..."
+		}
+		return fmt.Sprintf("✗ Error: %s", errMsg)
+	}
+}
+
+// formatDeleteError converts provider errors from deleting to user-friendly messages
+func (m Model) formatDeleteError(err error) string {
+	switch {
+	case errors.Is(err, core.ErrInsufficientScope):
+		return "✗ Insufficient permissions - please re-authenticate with 'tsk auth'"
+	case errors.Is(err, core.ErrNotOrganizer):
+		return "✗ Cannot delete: Only the organizer can delete this event (decline it instead)"
+	case errors.Is(err, core.ErrNotImplemented):
+		return "✗ Deleting events is not supported for this calendar provider"
+	case errors.Is(err, core.ErrOffline):
+		return "✗ Cannot delete while offline"
+	default:
+		errMsg := err.Error()
+		if len(errMsg) > 80 {
+			errMsg = errMsg[:77] + "..."
+		}
+		return fmt.Sprintf("✗ Error: %s", errMsg)
+	}
+}
+
 // getCannotRespondMessage returns an appropriate error message for why the user cannot respond
 func (m Model) getCannotRespondMessage(event core.Event) string {
 	// Check if it's a subscribed calendar event (e.g., holidays, shared calendars)
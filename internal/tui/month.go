@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+// monthGrid returns the first day shown in the month grid of t's month (the
+// Monday on or before the 1st) and how many weeks the grid has.
+func monthGrid(t time.Time) (time.Time, int) {
+	t = t.Local()
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+	start := weekStart(first)
+	days := int(first.AddDate(0, 1, 0).Sub(start).Hours()/24 + 0.5)
+	return start, (days + 6) / 7
+}
+
+// moveMonthDay moves the selected day in the month grid. Leaving the month
+// loads the next one.
+func (m *Model) moveMonthDay(delta int) tea.Cmd {
+	prev := m.currentDate
+	m.currentDate = m.currentDate.AddDate(0, 0, delta)
+	if m.currentDate.Month() != prev.Month() || m.currentDate.Year() != prev.Year() {
+		m.loading = true
+		return m.loadEvents()
+	}
+	return nil
+}
+
+// monthTitle describes the displayed month for the header
+func (m Model) monthTitle() string {
+	title := m.currentDate.Format("January 2006")
+	now := time.Now()
+	if m.currentDate.Year() == now.Year() && m.currentDate.Month() == now.Month() {
+		title = "This month • " + title
+	}
+	return title
+}
+
+// renderMonthPanel renders the month grid: a row of weekday names, then a
+// row of day cells per week. A cell lists the day's events, all-day ones
+// first, as many as fit, and marks days with out-of-office time.
+func (m Model) renderMonthPanel() string {
+	width := m.listWidth - 2 // Inside the panel padding
+	start, weeks := monthGrid(m.currentDate)
+
+	cellWidths := make([]int, 7)
+	for d := range cellWidths {
+		cellWidths[d] = width*(d+1)/7 - width*d/7 - 1 // Each column starts with a separator
+		if cellWidths[d] < 1 {
+			cellWidths[d] = 1
+		}
+	}
+
+	// Weeks are divided by a line; share what's left between them
+	cellHeights := make([]int, weeks)
+	avail := m.listHeight - 1 - (weeks - 1)
+	for w := range cellHeights {
+		cellHeights[w] = avail*(w+1)/weeks - avail*w/weeks
+		if cellHeights[w] < 1 {
+			cellHeights[w] = 1
+		}
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	separator := mutedStyle.Render("│")
+	var lines []string
+
+	header := ""
+	for d := 0; d < 7; d++ {
+		header += " " + mutedStyle.Render(fitText(start.AddDate(0, 0, d).Format("Mon"), cellWidths[d]))
+	}
+	lines = append(lines, header)
+
+	now := time.Now()
+	for w := 0; w < weeks; w++ {
+		if w > 0 {
+			rule := ""
+			for d := 0; d < 7; d++ {
+				joint := "┼"
+				if d == 0 {
+					joint = "─"
+				}
+				rule += joint + strings.Repeat("─", cellWidths[d])
+			}
+			lines = append(lines, mutedStyle.Render(rule))
+		}
+
+		cells := make([][]string, 7)
+		for d := 0; d < 7; d++ {
+			day := start.AddDate(0, 0, w*7+d)
+			cells[d] = m.renderMonthCell(day, now, cellWidths[d], cellHeights[w])
+		}
+		for l := 0; l < cellHeights[w]; l++ {
+			row := ""
+			for d := 0; d < 7; d++ {
+				sep := separator
+				if d == 0 {
+					sep = " "
+				}
+				row += sep + cells[d][l]
+			}
+			lines = append(lines, row)
+		}
+	}
+
+	return ListPanelStyle.Width(m.listWidth).Height(m.listHeight).Render(strings.Join(lines, "\n"))
+}
+
+// renderMonthCell renders the lines of one day in the month grid
+func (m Model) renderMonthCell(day, now time.Time, width, height int) []string {
+	idxs := m.dayEventIdxs(day)
+	inMonth := day.Month() == m.currentDate.Month()
+
+	ooo := false
+	for _, idx := range idxs {
+		if m.events[idx].Type == core.TypeOutOfOffice {
+			ooo = true
+		}
+	}
+
+	// First line: the date, an OOO marker and the number of events
+	label := day.Format("2")
+	if ooo {
+		label += " OOO"
+	}
+	count := ""
+	if len(idxs) > 0 {
+		count = fmt.Sprintf("%d", len(idxs))
+	}
+	gap := width - len(label) - len(count)
+	if gap < 1 {
+		count = ""
+		gap = width - len(label)
+	}
+	first := fitText(label+strings.Repeat(" ", max(gap, 0))+count, width)
+
+	dayStyle := lipgloss.NewStyle().Foreground(fgColor)
+	switch {
+	case sameDay(day, m.currentDate):
+		dayStyle = WeekSelectedStyle
+	case sameDay(day, now):
+		dayStyle = lipgloss.NewStyle().Foreground(accentColor).Bold(true)
+	case !inMonth:
+		dayStyle = lipgloss.NewStyle().Foreground(mutedColor).Faint(true)
+	case ooo:
+		dayStyle = lipgloss.NewStyle().Foreground(accentColor)
+	}
+	lines := []string{dayStyle.Render(first)}
+
+	// Then the events, leaving the last line for how many don't fit
+	for i, idx := range idxs {
+		if len(lines) == height {
+			break
+		}
+		if len(lines) == height-1 && i < len(idxs)-1 {
+			lines = append(lines, WeekMoreStyle.Render(fitText(fmt.Sprintf("+%d more", len(idxs)-i), width)))
+			break
+		}
+
+		e := m.events[idx]
+		style := lipgloss.NewStyle().Foreground(fgColor)
+		var text string
+		switch {
+		case e.IsAllDay:
+			// All-day events read as a bar across the cell
+			text = e.Title
+			style = WeekEventStyle
+			if e.Type == core.TypeOutOfOffice {
+				style = lipgloss.NewStyle().Background(accentColor).Foreground(bgColor)
+			}
+		case width >= 14:
+			text = e.Start.Local().Format("3:04pm") + " " + e.Title
+		default:
+			text = e.Title
+		}
+		if e.End.Before(now) || e.Status == core.StatusRejected {
+			style = WeekPastStyle
+		}
+		if !inMonth {
+			style = style.Faint(true)
+		}
+		lines = append(lines, style.Render(fitText(text, width)))
+	}
+
+	for len(lines) < height {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+	return lines
+}
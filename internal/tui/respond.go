@@ -191,12 +191,15 @@ func (m RespondModal) Update(msg tea.Msg) (RespondModal, tea.Cmd) {
 				return m, nil
 			}
 
-		case key.Matches(msg, key.NewBinding(key.WithKeys("y", "n"))):
+		case key.Matches(msg, key.NewBinding(key.WithKeys("y", "n", "f"))):
 			// Handle recurring scope toggle
 			if m.event.IsRecurring() && m.focusIndex == m.recurringScopeFocusIdx() {
-				if msg.String() == "y" {
+				switch msg.String() {
+				case "y":
 					m.recurringScope = core.RecurringScopeAllInstances
-				} else {
+				case "f":
+					m.recurringScope = core.RecurringScopeThisAndFollowing
+				default:
 					m.recurringScope = core.RecurringScopeThisInstance
 				}
 				return m, nil
@@ -482,7 +485,21 @@ func (m RespondModal) renderRecurringScopeSection() string {
 	options.WriteString(thisStyle.Render(fmt.Sprintf("  %s This event only (n)", marker)))
 	options.WriteString("\n")
 
-	// Option 2: All instances
+	// Option 2: This and following instances
+	marker = " "
+	if m.recurringScope == core.RecurringScopeThisAndFollowing {
+		marker = "●"
+	}
+	followingStyle := lipgloss.NewStyle()
+	if isFocused && m.recurringScope == core.RecurringScopeThisAndFollowing {
+		followingStyle = followingStyle.Foreground(accentColor).Bold(true)
+	} else if m.recurringScope == core.RecurringScopeThisAndFollowing {
+		followingStyle = followingStyle.Foreground(primaryColor)
+	}
+	options.WriteString(followingStyle.Render(fmt.Sprintf("  %s This and following events (f)", marker)))
+	options.WriteString("\n")
+
+	// Option 3: All instances
 	marker = " "
 	if m.recurringScope == core.RecurringScopeAllInstances {
 		marker = "●"
@@ -0,0 +1,347 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/theakshaypant/tsk/internal/core"
+	"github.com/theakshaypant/tsk/internal/util"
+)
+
+// filterType is an event type that can be shown or hidden with a number key
+type filterType struct {
+	key   string
+	label string
+	short string // Label when the full ones don't fit
+	typ   core.EventType
+}
+
+// filterStatus is a response status that can be shown or hidden with a
+// number key
+type filterStatus struct {
+	key    string
+	label  string
+	short  string
+	status core.EventStatus
+}
+
+var filterTypes = []filterType{
+	{"1", "events", "events", core.TypeDefault},
+	{"2", "out of office", "ooo", core.TypeOutOfOffice},
+	{"3", "focus time", "focus", core.TypeFocusTime},
+	{"4", "working location", "location", core.TypeWorkLocation},
+}
+
+var filterStatuses = []filterStatus{
+	{"5", "accepted", "yes", core.StatusAccepted},
+	{"6", "tentative", "maybe", core.StatusTentative},
+	{"7", "awaiting", "awaiting", core.StatusAwaiting},
+	{"8", "declined", "no", core.StatusRejected},
+	{"9", "no response", "none", core.StatusNoResponse},
+}
+
+// newSearchInput creates the text input of the search prompt
+func newSearchInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "/ "
+	input.Placeholder = "title, location, description, guest or calendar"
+	input.CharLimit = 100
+	input.PromptStyle = HelpKeyStyle
+	return input
+}
+
+// initFilters takes the event types and statuses to show from the fetch
+// options, which then fetch everything so the toggles apply without
+// fetching again.
+func (m *Model) initFilters() {
+	m.shownTypes = make(map[core.EventType]bool)
+	if len(m.fetchOptions.IncludeTypes) == 0 {
+		m.shownTypes[core.TypeDefault] = true
+	}
+	for _, t := range m.fetchOptions.IncludeTypes {
+		m.shownTypes[t] = true
+	}
+
+	m.shownStatuses = make(map[core.EventStatus]bool)
+	for _, s := range filterStatuses {
+		m.shownStatuses[s.status] = len(m.fetchOptions.IncludeStatuses) == 0
+	}
+	for _, s := range m.fetchOptions.IncludeStatuses {
+		m.shownStatuses[s] = true
+	}
+
+	m.fetchOptions.IncludeTypes = nil
+	for _, t := range filterTypes {
+		m.fetchOptions.IncludeTypes = append(m.fetchOptions.IncludeTypes, t.typ)
+	}
+	m.fetchOptions.IncludeStatuses = nil
+}
+
+// setEvents replaces the loaded events and shows those that pass the filters
+func (m *Model) setEvents(events []core.Event) {
+	m.allEvents = events
+	m.applyFilters()
+}
+
+// applyFilters rebuilds the shown events from the loaded ones, keeping the
+// selection on the same event when it's still shown.
+func (m *Model) applyFilters() {
+	var selected core.Event
+	hadSelection := m.selectedIdx >= 0 && m.selectedIdx < len(m.events)
+	if hadSelection {
+		selected = m.events[m.selectedIdx]
+	}
+
+	terms := strings.Fields(m.query)
+	m.events = make([]core.Event, 0, len(m.allEvents))
+	for _, e := range m.allEvents {
+		if m.shownTypes[e.Type] && m.shownStatuses[e.Status] && matchesTerms(e, terms) {
+			m.events = append(m.events, e)
+		}
+	}
+	if m.viewMode == ViewAgenda {
+		m.sortAgenda()
+	}
+
+	if hadSelection {
+		for i, e := range m.events {
+			if e.ID == selected.ID && e.Start.Equal(selected.Start) {
+				m.selectedIdx = i
+				return
+			}
+		}
+	}
+	if m.selectedIdx >= len(m.events) {
+		m.selectedIdx = len(m.events) - 1
+	}
+	if m.selectedIdx < 0 {
+		m.selectedIdx = 0
+	}
+}
+
+// refilter applies changed filters and redraws around the selection
+func (m *Model) refilter() {
+	m.applyFilters()
+	if _, ok := m.selectedEvent(); !ok {
+		m.selectedIdx = m.findNowEventIdx()
+	}
+	m.updateListContent()
+	m.scrollListToSelection()
+	m.updateDetailContent()
+	m.detailView.GotoTop()
+}
+
+// matchesTerms reports whether every term matches the event: fuzzily in
+// the title, location, guests or calendars, or as is in the description.
+func matchesTerms(e core.Event, terms []string) bool {
+	if len(terms) == 0 {
+		return true
+	}
+
+	fields := []string{e.Title, e.Location, e.Calendar.Name, e.OrganizerName, e.Organizer}
+	for _, cr := range e.Calendars {
+		fields = append(fields, cr.Calendar.Name)
+	}
+	for _, a := range e.Attendees {
+		fields = append(fields, a.Name, a.Email)
+	}
+	description := strings.ToLower(util.HTMLToText(e.Description, 1000))
+
+	for _, term := range terms {
+		found := strings.Contains(description, strings.ToLower(term))
+		for _, field := range fields {
+			if found {
+				break
+			}
+			_, found = fuzzyMatch(term, field)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// fuzzyMatch reports whether the letters of pattern appear in text in order,
+// ignoring case, and returns their positions in text's runes. A contiguous
+// match is preferred when there is one.
+func fuzzyMatch(pattern, text string) ([]int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(text)
+	for i, r := range t {
+		t[i] = unicode.ToLower(r)
+	}
+	if len(p) == 0 || len(p) > len(t) {
+		return nil, len(p) == 0
+	}
+
+	// Contiguous
+	for start := 0; start+len(p) <= len(t); start++ {
+		j := 0
+		for j < len(p) && t[start+j] == p[j] {
+			j++
+		}
+		if j == len(p) {
+			positions := make([]int, len(p))
+			for k := range positions {
+				positions[k] = start + k
+			}
+			return positions, true
+		}
+	}
+
+	// Scattered
+	var positions []int
+	for i := 0; i < len(t) && len(positions) < len(p); i++ {
+		if t[i] == p[len(positions)] {
+			positions = append(positions, i)
+		}
+	}
+	return positions, len(positions) == len(p)
+}
+
+// highlightMatches underlines the letters of s matched by the search.
+// Only underlining is switched on and off, so the style s is rendered in
+// carries on around the matches.
+func (m Model) highlightMatches(s string) string {
+	terms := strings.Fields(m.query)
+	if len(terms) == 0 {
+		return s
+	}
+
+	matched := make(map[int]bool)
+	for _, term := range terms {
+		positions, _ := fuzzyMatch(term, s)
+		for _, p := range positions {
+			matched[p] = true
+		}
+	}
+	if len(matched) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(s) {
+		if matched[i] {
+			b.WriteString("\x1b[4m" + string(r) + "\x1b[24m")
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// startSearch shows the search prompt with the current query
+func (m *Model) startSearch() {
+	m.searching = true
+	m.searchInput.SetValue(m.query)
+	m.searchInput.CursorEnd()
+	m.searchInput.Width = max(m.width-40, 10)
+	m.searchInput.Focus()
+}
+
+// updateSearch handles a key while the search prompt has focus. The list
+// follows as the query is typed; enter keeps the query and esc drops it.
+func (m *Model) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+		m.query = ""
+		m.refilter()
+		return nil
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		return nil
+	case "up":
+		// Move through the matches without leaving the prompt
+		return m.moveSelection(-1)
+	case "down":
+		return m.moveSelection(1)
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if query := m.searchInput.Value(); query != m.query {
+		m.query = query
+		m.refilter()
+	}
+	return cmd
+}
+
+// updateFilterToggles handles a key while the type and status toggles are
+// showing: a number shows or hides what it stands for, and esc, enter or f
+// puts them away.
+func (m *Model) updateFilterToggles(msg tea.KeyMsg) {
+	k := msg.String()
+	for _, t := range filterTypes {
+		if k == t.key {
+			m.shownTypes[t.typ] = !m.shownTypes[t.typ]
+			m.refilter()
+			return
+		}
+	}
+	for _, s := range filterStatuses {
+		if k == s.key {
+			m.shownStatuses[s.status] = !m.shownStatuses[s.status]
+			m.refilter()
+			return
+		}
+	}
+	switch k {
+	case "esc", "enter", "f":
+		m.showFilters = false
+	}
+}
+
+// renderSearchBar renders the search prompt in place of the help bar
+func (m Model) renderSearchBar() string {
+	count := lipgloss.NewStyle().Foreground(mutedColor).Render(fmt.Sprintf("%d of %d", len(m.events), len(m.allEvents)))
+	hint := HelpKeyStyle.Render("enter") + " keep  •  " + HelpKeyStyle.Render("esc") + " clear"
+	return HelpStyle.Render(m.searchInput.View() + "  " + count + "  •  " + hint)
+}
+
+// renderFilterBar renders the type and status toggles in place of the help
+// bar, shortening the labels when they don't fit.
+func (m Model) renderFilterBar() string {
+	render := func(short bool) string {
+		toggle := func(k, label, shortLabel string, shown bool) string {
+			if short {
+				label = shortLabel
+			}
+			mark := StatusDeclinedStyle.Render("✗")
+			if shown {
+				mark = StatusAcceptedStyle.Render("✓")
+			}
+			return HelpKeyStyle.Render(k) + " " + mark + label
+		}
+
+		var types, statuses []string
+		for _, t := range filterTypes {
+			types = append(types, toggle(t.key, t.label, t.short, m.shownTypes[t.typ]))
+		}
+		for _, s := range filterStatuses {
+			statuses = append(statuses, toggle(s.key, s.label, s.short, m.shownStatuses[s.status]))
+		}
+		return "Show: " + strings.Join(types, " ") + "  │  " + strings.Join(statuses, " ") +
+			"  •  " + HelpKeyStyle.Render("f") + " done"
+	}
+
+	line := render(false)
+	if lipgloss.Width(line) > m.width-4 {
+		line = render(true)
+	}
+	return HelpStyle.Render(line)
+}
+
+// renderSearchStatus shows the kept search query for the help bar
+func (m Model) renderSearchStatus() string {
+	label := fmt.Sprintf("🔍 “%s” • %d of %d", m.query, len(m.events), len(m.allEvents))
+	return lipgloss.NewStyle().Foreground(accentColor).Render(label) +
+		"  " + HelpKeyStyle.Render("esc") + " clear"
+}
@@ -49,4 +49,11 @@ var (
 
 	// Calendar badge
 	CalendarBadgeStyle = lipgloss.NewStyle().Foreground(mutedColor).Italic(true)
+
+	// Week grid blocks
+	WeekEventStyle      = lipgloss.NewStyle().Background(lipgloss.Color("#374151")).Foreground(fgColor)
+	WeekPastStyle       = lipgloss.NewStyle().Background(bgColor).Foreground(lipgloss.Color("#9CA3AF")).Faint(true)
+	WeekInProgressStyle = lipgloss.NewStyle().Background(secondaryColor).Foreground(fgColor).Bold(true)
+	WeekSelectedStyle   = lipgloss.NewStyle().Background(primaryColor).Foreground(fgColor).Bold(true)
+	WeekMoreStyle       = lipgloss.NewStyle().Foreground(mutedColor).Italic(true)
 )
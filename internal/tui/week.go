@@ -0,0 +1,533 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/theakshaypant/tsk/internal/core"
+)
+
+const (
+	weekSlot       = 30 * time.Minute // Time covered by one grid row
+	weekSlots      = 48               // Grid rows in a day
+	weekGutter     = 6                // Width of the time labels, with a space
+	weekAllDayRows = 2                // Most rows of all-day events above the grid
+	weekDayStart   = 16               // Row scrolled to when nothing else matters (8 AM)
+)
+
+// weekBlock is an event drawn in a day column of the week grid. Events that
+// overlap share the column: each gets one of lanes equal slices.
+type weekBlock struct {
+	idx      int // Index into m.events
+	lane     int
+	lanes    int
+	startRow int
+	endRow   int // Exclusive
+}
+
+// startOfDay returns local midnight of t's day
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// weekStart returns local midnight of the Monday starting t's week
+func weekStart(t time.Time) time.Time {
+	day := startOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7 // Days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// sameDay reports whether a and b fall on the same local day
+func sameDay(a, b time.Time) bool {
+	return startOfDay(a).Equal(startOfDay(b))
+}
+
+// onDay reports whether an event is shown on day. All-day events are
+// matched by date, since providers store them as midnights in UTC.
+func onDay(e core.Event, day time.Time) bool {
+	dayStart := startOfDay(day)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	start, end := e.Start, e.End
+	if e.IsAllDay {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+		end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.Local)
+	}
+	if !end.After(start) {
+		// Zero-length events belong to the day they start on
+		return !start.Before(dayStart) && start.Before(dayEnd)
+	}
+	return start.Before(dayEnd) && end.After(dayStart)
+}
+
+// dayEventIdxs returns the indices of the events shown on day, all-day
+// events first, each group in start order.
+func (m Model) dayEventIdxs(day time.Time) []int {
+	var allDay, timed []int
+	for i, e := range m.events {
+		if !onDay(e, day) {
+			continue
+		}
+		if e.IsAllDay {
+			allDay = append(allDay, i)
+		} else {
+			timed = append(timed, i)
+		}
+	}
+	return append(allDay, timed...)
+}
+
+// dayRow returns the grid row of t on day, clamped to the day
+func dayRow(t, day time.Time) int {
+	row := int(t.Sub(startOfDay(day)) / weekSlot)
+	if row < 0 {
+		return 0
+	}
+	if row >= weekSlots {
+		return weekSlots - 1
+	}
+	return row
+}
+
+// layoutDay places the timed events of day in the grid. Events that overlap
+// (directly or through others) form a group whose members get side-by-side
+// lanes; an event takes the first lane free at its start.
+func (m Model) layoutDay(day time.Time) []weekBlock {
+	var blocks []weekBlock
+	var laneEnds []int // End row of the last block in each lane of the group
+	groupStart := 0
+
+	closeGroup := func() {
+		for i := groupStart; i < len(blocks); i++ {
+			blocks[i].lanes = len(laneEnds)
+		}
+		groupStart = len(blocks)
+		laneEnds = nil
+	}
+
+	groupEnd := -1
+	for _, idx := range m.dayEventIdxs(day) {
+		e := m.events[idx]
+		if e.IsAllDay {
+			continue
+		}
+		startRow := dayRow(e.Start, day)
+		endRow := startRow + 1
+		if e.End.After(e.Start) {
+			// Round the end up to a whole row
+			endRow = dayRow(e.End.Add(-time.Nanosecond), day) + 1
+			if endRow <= startRow {
+				endRow = startRow + 1
+			}
+		}
+
+		if startRow >= groupEnd {
+			closeGroup()
+		}
+		if endRow > groupEnd {
+			groupEnd = endRow
+		}
+
+		lane := len(laneEnds)
+		for l, laneEnd := range laneEnds {
+			if laneEnd <= startRow {
+				lane = l
+				break
+			}
+		}
+		if lane == len(laneEnds) {
+			laneEnds = append(laneEnds, endRow)
+		} else {
+			laneEnds[lane] = endRow
+		}
+
+		blocks = append(blocks, weekBlock{idx: idx, lane: lane, startRow: startRow, endRow: endRow})
+	}
+	closeGroup()
+	return blocks
+}
+
+// weekAllDayLines returns how many lines the all-day strip needs
+func (m Model) weekAllDayLines() int {
+	lines := 0
+	day := weekStart(m.currentDate)
+	for d := 0; d < 7; d++ {
+		n := 0
+		for _, e := range m.events {
+			if e.IsAllDay && onDay(e, day) {
+				n++
+			}
+		}
+		if n > lines {
+			lines = n
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	if lines > weekAllDayRows {
+		lines = weekAllDayRows
+	}
+	return lines
+}
+
+// weekGridRows returns how many time rows fit in the week panel
+func (m Model) weekGridRows() int {
+	// The day names take the first line of the panel
+	rows := m.listHeight - 1 - m.weekAllDayLines()
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// clampWeekScroll keeps the grid scrolled within the day
+func (m *Model) clampWeekScroll() {
+	maxScroll := weekSlots - m.weekGridRows()
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if m.weekScroll > maxScroll {
+		m.weekScroll = maxScroll
+	}
+	if m.weekScroll < 0 {
+		m.weekScroll = 0
+	}
+}
+
+// resetWeekScroll scrolls the grid to now in the current week, or to the
+// start of the working day (earlier if an event starts before it).
+func (m *Model) resetWeekScroll() {
+	now := time.Now()
+	start := weekStart(m.currentDate)
+	if !now.Before(start) && now.Before(start.AddDate(0, 0, 7)) {
+		m.weekScroll = dayRow(now, now) - 2
+	} else {
+		m.weekScroll = weekDayStart
+		for _, e := range m.events {
+			if e.IsAllDay {
+				continue
+			}
+			if row := dayRow(e.Start, e.Start); row < m.weekScroll {
+				m.weekScroll = row
+			}
+		}
+	}
+	m.clampWeekScroll()
+	m.scrollWeekToSelection()
+}
+
+// scrollWeekToSelection scrolls the grid so the selected event is visible
+func (m *Model) scrollWeekToSelection() {
+	event, ok := m.selectedEvent()
+	if !ok || event.IsAllDay {
+		return
+	}
+	for _, b := range m.layoutDay(m.currentDate) {
+		if b.idx != m.selectedIdx {
+			continue
+		}
+		rows := m.weekGridRows()
+		if b.endRow > m.weekScroll+rows {
+			m.weekScroll = b.endRow - rows
+		}
+		if b.startRow < m.weekScroll {
+			m.weekScroll = b.startRow
+		}
+		m.clampWeekScroll()
+		return
+	}
+}
+
+// weekSelectIdx returns the event to select on the current day: the next
+// one on today, the first on other days, or -1 for a day without events.
+func (m Model) weekSelectIdx() int {
+	idxs := m.dayEventIdxs(m.currentDate)
+	if len(idxs) == 0 {
+		return -1
+	}
+	now := time.Now()
+	if !sameDay(m.currentDate, now) {
+		return idxs[0]
+	}
+	for _, idx := range idxs {
+		if e := m.events[idx]; !e.IsAllDay && e.Start.After(now) {
+			return idx
+		}
+	}
+	return idxs[len(idxs)-1]
+}
+
+// moveWeekSelection moves the selection up or down within the selected day
+func (m *Model) moveWeekSelection(delta int) {
+	idxs := m.dayEventIdxs(m.currentDate)
+	if len(idxs) == 0 {
+		return
+	}
+	pos := -1
+	for i, idx := range idxs {
+		if idx == m.selectedIdx {
+			pos = i
+		}
+	}
+	switch {
+	case pos == -1:
+		pos = 0
+	case pos+delta < 0 || pos+delta >= len(idxs):
+		return
+	default:
+		pos += delta
+	}
+	m.selectedIdx = idxs[pos]
+	m.scrollWeekToSelection()
+	m.updateDetailContent()
+	m.detailView.GotoTop()
+}
+
+// moveWeekDay moves the selected day, picking the event closest in time to
+// the one selected before. Leaving the week loads the next one.
+func (m *Model) moveWeekDay(delta int) tea.Cmd {
+	prev, hadPrev := m.selectedEvent()
+	prevWeek := weekStart(m.currentDate)
+	m.currentDate = m.currentDate.AddDate(0, 0, delta)
+	if !weekStart(m.currentDate).Equal(prevWeek) {
+		m.loading = true
+		return m.loadEvents()
+	}
+
+	m.selectedIdx = -1
+	idxs := m.dayEventIdxs(m.currentDate)
+	if len(idxs) > 0 {
+		m.selectedIdx = idxs[0]
+	}
+	if hadPrev && !prev.IsAllDay {
+		// Compare times of day, in grid rows
+		target := dayRow(prev.Start, prev.Start)
+		best := -1
+		for _, idx := range idxs {
+			e := m.events[idx]
+			if e.IsAllDay {
+				continue
+			}
+			dist := dayRow(e.Start, m.currentDate) - target
+			if dist < 0 {
+				dist = -dist
+			}
+			if best == -1 || dist < best {
+				best = dist
+				m.selectedIdx = idx
+			}
+		}
+	}
+
+	m.scrollWeekToSelection()
+	m.updateDetailContent()
+	m.detailView.GotoTop()
+	return nil
+}
+
+// weekTitle describes the displayed week for the header
+func (m Model) weekTitle() string {
+	start := weekStart(m.currentDate)
+	end := start.AddDate(0, 0, 6)
+	title := fmt.Sprintf("Week of %s – %s", start.Format("Jan 2"), end.Format("Jan 2, 2006"))
+	if weekStart(time.Now()).Equal(start) {
+		title = "This week • " + title
+	}
+	return title
+}
+
+// renderWeekPanel renders the week grid: a header row with the days, the
+// all-day strip and the time rows, in place of the event list.
+func (m Model) renderWeekPanel() string {
+	// Share the width between the days; each column starts with a separator
+	gridWidth := m.listWidth - 2 - weekGutter // Inside the panel padding
+	days := make([]time.Time, 7)
+	cellWidths := make([]int, 7)
+	for d := range days {
+		days[d] = weekStart(m.currentDate).AddDate(0, 0, d)
+		cellWidths[d] = gridWidth*(d+1)/7 - gridWidth*d/7 - 1
+		if cellWidths[d] < 1 {
+			cellWidths[d] = 1
+		}
+	}
+
+	now := time.Now()
+
+	separator := lipgloss.NewStyle().Foreground(mutedColor).Render("│")
+	var lines []string
+
+	// Day names
+	header := strings.Repeat(" ", weekGutter)
+	for d, day := range days {
+		style := lipgloss.NewStyle().Foreground(mutedColor)
+		if sameDay(day, now) {
+			style = lipgloss.NewStyle().Foreground(accentColor).Bold(true)
+		}
+		if sameDay(day, m.currentDate) {
+			style = WeekSelectedStyle
+		}
+		header += separator + style.Render(fitText(weekDayLabel(day, cellWidths[d]), cellWidths[d]))
+	}
+	lines = append(lines, header)
+
+	// All-day strip; a day with more events than fit shows how many are hidden
+	allDayLines := m.weekAllDayLines()
+	for line := 0; line < allDayLines; line++ {
+		row := strings.Repeat(" ", weekGutter)
+		for d, day := range days {
+			cellWidth := cellWidths[d]
+			var idxs []int
+			for _, idx := range m.dayEventIdxs(day) {
+				if m.events[idx].IsAllDay {
+					idxs = append(idxs, idx)
+				}
+			}
+
+			cell := strings.Repeat(" ", cellWidth)
+			switch {
+			case line == allDayLines-1 && len(idxs) > allDayLines:
+				hidden := idxs[line:]
+				style := WeekMoreStyle
+				for _, idx := range hidden {
+					if idx == m.selectedIdx && sameDay(day, m.currentDate) {
+						style = WeekSelectedStyle
+					}
+				}
+				cell = style.Render(fitText(fmt.Sprintf("+%d more", len(hidden)), cellWidth))
+			case line < len(idxs):
+				idx := idxs[line]
+				cell = m.weekEventStyle(idx, day, now).Render(fitText(m.events[idx].Title, cellWidth))
+			}
+			row += separator + cell
+		}
+		lines = append(lines, row)
+	}
+
+	// Time rows
+	layouts := make([][]weekBlock, 7)
+	for d, day := range days {
+		layouts[d] = m.layoutDay(day)
+	}
+	nowRow := dayRow(now, now)
+	rows := m.weekGridRows()
+	for r := m.weekScroll; r < m.weekScroll+rows && r < weekSlots; r++ {
+		// Hours on full-hour rows, the time on the NOW row, and arrows
+		// when there's more of the day above or below
+		var gutter string
+		switch {
+		case r == nowRow && !now.Before(days[0]) && now.Before(days[0].AddDate(0, 0, 7)):
+			gutter = lipgloss.NewStyle().Foreground(accentColor).Bold(true).Render(fmt.Sprintf("%5s", now.Format("3:04")))
+		case r%2 == 0:
+			label := days[0].Add(time.Duration(r) * weekSlot).Format("3 PM")
+			gutter = TimeStyle.UnsetWidth().Render(fmt.Sprintf("%5s", label))
+		default:
+			gutter = strings.Repeat(" ", weekGutter-1)
+		}
+		switch {
+		case r == m.weekScroll && r > 0:
+			gutter += lipgloss.NewStyle().Foreground(mutedColor).Render("↑")
+		case r == m.weekScroll+rows-1 && r < weekSlots-1:
+			gutter += lipgloss.NewStyle().Foreground(mutedColor).Render("↓")
+		default:
+			gutter += " "
+		}
+
+		row := gutter
+		for d, day := range days {
+			fill := " "
+			fillStyle := lipgloss.NewStyle()
+			if r == nowRow && sameDay(day, now) {
+				fill = "─"
+				fillStyle = lipgloss.NewStyle().Foreground(accentColor)
+			}
+			row += separator + m.renderWeekCell(layouts[d], r, day, now, cellWidths[d], fill, fillStyle)
+		}
+		lines = append(lines, row)
+	}
+
+	return ListPanelStyle.Width(m.listWidth).Height(m.listHeight).Render(strings.Join(lines, "\n"))
+}
+
+// renderWeekCell renders one day column of a time row. Each block covering
+// the row paints its lane; the title goes on the block's first visible row.
+func (m Model) renderWeekCell(blocks []weekBlock, row int, day, now time.Time, width int, fill string, fillStyle lipgloss.Style) string {
+	owner := make([]int, width) // Block painted at each column, or -1
+	for x := range owner {
+		owner[x] = -1
+	}
+	for bi, b := range blocks {
+		if row < b.startRow || row >= b.endRow {
+			continue
+		}
+		x0, x1 := width*b.lane/b.lanes, width*(b.lane+1)/b.lanes
+		for x := x0; x < x1; x++ {
+			owner[x] = bi
+		}
+	}
+
+	var cell strings.Builder
+	for x := 0; x < width; {
+		end := x
+		for end < width && owner[end] == owner[x] {
+			end++
+		}
+		if owner[x] == -1 {
+			cell.WriteString(fillStyle.Render(strings.Repeat(fill, end-x)))
+		} else {
+			b := blocks[owner[x]]
+			x0, x1 := width*b.lane/b.lanes, width*(b.lane+1)/b.lanes
+			label := ""
+			if row == b.startRow || row == m.weekScroll {
+				label = m.events[b.idx].Title
+			}
+			text := ansi.Cut(fitText(label, x1-x0), x-x0, end-x0)
+			cell.WriteString(m.weekEventStyle(b.idx, day, now).Render(fitText(text, end-x)))
+		}
+		x = end
+	}
+	return cell.String()
+}
+
+// weekEventStyle picks the style of an event's block in the grid
+func (m Model) weekEventStyle(idx int, day, now time.Time) lipgloss.Style {
+	e := m.events[idx]
+	switch {
+	case idx == m.selectedIdx && sameDay(day, m.currentDate):
+		return WeekSelectedStyle
+	case e.InProgress(now):
+		return WeekInProgressStyle
+	case e.End.Before(now), e.Status == core.StatusRejected:
+		return WeekPastStyle
+	default:
+		return WeekEventStyle
+	}
+}
+
+// weekDayLabel names a day in the grid header, shorter for narrow columns
+func weekDayLabel(day time.Time, width int) string {
+	for _, label := range []string{
+		day.Format("Mon 2"),
+		day.Format("Mon")[:1] + " " + day.Format("2"),
+	} {
+		if len(label) <= width {
+			return label
+		}
+	}
+	return day.Format("2")
+}
+
+// fitText truncates or pads s to exactly width cells
+func fitText(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	s = ansi.Truncate(s, width, "…")
+	if pad := width - ansi.StringWidth(s); pad > 0 {
+		s += strings.Repeat(" ", pad)
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// IsNetworkError reports whether err means the provider couldn't be reached,
+// as opposed to the provider rejecting the request.
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	// Some SDKs flatten transport errors into strings
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{
+		"no such host",
+		"connection refused",
+		"network is unreachable",
+		"i/o timeout",
+		"dial tcp",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadLocation(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Europe/Berlin", "Europe/Berlin"},
+		{"W. Europe Standard Time", "Europe/Berlin"},
+		{"Eastern Standard Time", "America/New_York"},
+		{"/mozilla.org/20050126_1/Europe/Berlin", "Europe/Berlin"},
+		{"/softwarestudio.org/Olson_20011030_5/America/New_York", "America/New_York"},
+	}
+	for _, tt := range tests {
+		loc, err := LoadLocation(tt.name)
+		if err != nil {
+			t.Errorf("LoadLocation(%q): %v", tt.name, err)
+			continue
+		}
+		if loc.String() != tt.want {
+			t.Errorf("LoadLocation(%q) = %s, want %s", tt.name, loc, tt.want)
+		}
+	}
+
+	for _, name := range []string{"Customized Time Zone", "Mars/Olympus_Mons"} {
+		if loc, err := LoadLocation(name); err == nil {
+			t.Errorf("LoadLocation(%q) = %s, want an error", name, loc)
+		}
+	}
+}
+
+func TestWindowsZones(t *testing.T) {
+	for windows, iana := range windowsZones {
+		if _, err := time.LoadLocation(iana); err != nil {
+			t.Errorf("%s: %v", windows, err)
+		}
+	}
+}